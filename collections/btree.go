@@ -0,0 +1,687 @@
+package collections
+
+import (
+	"sort"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// btreeDegree is the minimum degree of the nodes of a BTreeMap. Every node
+// other than the root holds between btreeDegree-1 and 2*btreeDegree-1 keys.
+const btreeDegree = 16
+
+// btreeMaxKeys is the maximum number of keys held by a single node.
+const btreeMaxKeys = 2*btreeDegree - 1
+
+type btreeNode[K constraints.Ordered, V any] struct {
+	keys     []K
+	values   []V
+	children []*btreeNode[K, V]
+}
+
+func (n *btreeNode[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// search returns the index of the first key in the node which is not less than
+// the provided key, or which is greater than it if strict is true.
+func (n *btreeNode[K, V]) search(key K, strict bool) int {
+	return sort.Search(len(n.keys), func(i int) bool {
+		if strict {
+			return n.keys[i] > key
+		}
+
+		return n.keys[i] >= key
+	})
+}
+
+// A BTreeMap is a map which keeps its entries sorted by key, backed by a
+// B-tree. Lookups, insertions and deletions run in logarithmic time, and the
+// entries can be traversed in order, or within a range of keys, without
+// sorting them.
+//
+// The zero value is an empty BTreeMap which is ready for use.
+type BTreeMap[K constraints.Ordered, V any] struct {
+	root *btreeNode[K, V]
+	size int
+}
+
+// NewBTreeMap returns a new, empty BTreeMap.
+func NewBTreeMap[K constraints.Ordered, V any]() *BTreeMap[K, V] {
+	return &BTreeMap[K, V]{}
+}
+
+// CollectBTreeMap consumes the provided iterator, converting each yielded value
+// into a key-value pair via the provided function and inserting it into a new
+// BTreeMap. If the same key is yielded more than once, the value which was
+// yielded last is kept.
+func CollectBTreeMap[K constraints.Ordered, V any](iterator iter.Interface[V], fn iter.MapEntryFunc[K, V]) *BTreeMap[K, V] {
+	out := NewBTreeMap[K, V]()
+	iter.ForEach(iterator, func(item *V) {
+		out.Set(fn(*item))
+	})
+
+	return out
+}
+
+// Len returns the number of entries in the map.
+func (m *BTreeMap[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns the value associated with the provided key, or a None value if
+// the key is not present in the map.
+func (m *BTreeMap[K, V]) Get(key K) oxide.Option[V] {
+	for node := m.root; node != nil; {
+		i := node.search(key, false)
+		if i < len(node.keys) && node.keys[i] == key {
+			return oxide.Some(node.values[i])
+		}
+
+		if node.leaf() {
+			break
+		}
+		node = node.children[i]
+	}
+
+	return oxide.None[V]()
+}
+
+// Contains returns true if the provided key is present in the map.
+func (m *BTreeMap[K, V]) Contains(key K) bool {
+	return m.Get(key).IsSome()
+}
+
+// Set associates the provided value with the provided key, returning true if
+// the key was not already present.
+func (m *BTreeMap[K, V]) Set(key K, value V) bool {
+	if m.root == nil {
+		m.root = &btreeNode[K, V]{}
+	}
+
+	// The root is split ahead of time if it is full, so that a key can always
+	// be inserted into the node at which the descent ends.
+	if len(m.root.keys) == btreeMaxKeys {
+		root := &btreeNode[K, V]{children: []*btreeNode[K, V]{m.root}}
+		root.splitChild(0)
+		m.root = root
+	}
+
+	inserted := m.root.insert(key, value)
+	if inserted {
+		m.size++
+	}
+
+	return inserted
+}
+
+// insert adds the key and value to the subtree rooted at the node, which must
+// not be full, returning true if the key was not already present.
+func (n *btreeNode[K, V]) insert(key K, value V) bool {
+	for {
+		i := n.search(key, false)
+		if i < len(n.keys) && n.keys[i] == key {
+			n.values[i] = value
+			return false
+		}
+
+		if n.leaf() {
+			n.keys = insertAt(n.keys, i, key)
+			n.values = insertAt(n.values, i, value)
+			return true
+		}
+
+		if len(n.children[i].keys) == btreeMaxKeys {
+			n.splitChild(i)
+
+			// The median key of the child was moved into this node, so the
+			// key may now belong to either half of the split.
+			if n.keys[i] == key {
+				n.values[i] = value
+				return false
+			} else if n.keys[i] < key {
+				i++
+			}
+		}
+
+		n = n.children[i]
+	}
+}
+
+// splitChild splits the full child at the provided index in two, moving its
+// median key up into this node.
+func (n *btreeNode[K, V]) splitChild(i int) {
+	child := n.children[i]
+	mid := btreeDegree - 1
+
+	right := &btreeNode[K, V]{
+		keys:   append([]K(nil), child.keys[mid+1:]...),
+		values: append([]V(nil), child.values[mid+1:]...),
+	}
+	if !child.leaf() {
+		right.children = append([]*btreeNode[K, V](nil), child.children[mid+1:]...)
+		clear(child.children[mid+1:])
+		child.children = child.children[:mid+1]
+	}
+
+	n.keys = insertAt(n.keys, i, child.keys[mid])
+	n.values = insertAt(n.values, i, child.values[mid])
+	n.children = insertAt(n.children, i+1, right)
+
+	clear(child.keys[mid:])
+	clear(child.values[mid:])
+	child.keys = child.keys[:mid]
+	child.values = child.values[:mid]
+}
+
+// Delete removes the entry with the provided key from the map, returning its
+// value, or a None value if the key was not present.
+func (m *BTreeMap[K, V]) Delete(key K) oxide.Option[V] {
+	if m.root == nil {
+		return oxide.None[V]()
+	}
+
+	removed := m.root.delete(key)
+	if removed.IsSome() {
+		m.size--
+	}
+
+	// The root is removed once it has no keys left, which is the only way in
+	// which the height of the tree shrinks.
+	if len(m.root.keys) == 0 && !m.root.leaf() {
+		m.root = m.root.children[0]
+	}
+
+	return removed
+}
+
+// delete removes the key from the subtree rooted at the node, which must hold
+// at least btreeDegree keys unless it is the root, returning its value.
+func (n *btreeNode[K, V]) delete(key K) oxide.Option[V] {
+	i := n.search(key, false)
+	found := i < len(n.keys) && n.keys[i] == key
+
+	if n.leaf() {
+		if !found {
+			return oxide.None[V]()
+		}
+
+		value := n.values[i]
+		n.keys = removeAt(n.keys, i)
+		n.values = removeAt(n.values, i)
+		return oxide.Some(value)
+	}
+
+	if found {
+		value := n.values[i]
+		left, right := n.children[i], n.children[i+1]
+
+		switch {
+		case len(left.keys) >= btreeDegree:
+			// The key is replaced by its predecessor, which is then removed
+			// from the left subtree instead.
+			pred := left.last()
+			n.keys[i], n.values[i] = pred.keys[len(pred.keys)-1], pred.values[len(pred.values)-1]
+			left.delete(n.keys[i])
+		case len(right.keys) >= btreeDegree:
+			succ := right.first()
+			n.keys[i], n.values[i] = succ.keys[0], succ.values[0]
+			right.delete(n.keys[i])
+		default:
+			n.merge(i)
+			left.delete(key)
+		}
+
+		return oxide.Some(value)
+	}
+
+	// The child which the descent continues into is topped up ahead of time,
+	// so that it can afford to lose a key.
+	if len(n.children[i].keys) < btreeDegree {
+		switch {
+		case i > 0 && len(n.children[i-1].keys) >= btreeDegree:
+			n.rotateRight(i - 1)
+		case i < len(n.keys) && len(n.children[i+1].keys) >= btreeDegree:
+			n.rotateLeft(i)
+		case i < len(n.keys):
+			n.merge(i)
+		default:
+			n.merge(i - 1)
+			i--
+		}
+	}
+
+	return n.children[i].delete(key)
+}
+
+// first returns the leaf which holds the least key of the subtree.
+func (n *btreeNode[K, V]) first() *btreeNode[K, V] {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+
+	return n
+}
+
+// last returns the leaf which holds the greatest key of the subtree.
+func (n *btreeNode[K, V]) last() *btreeNode[K, V] {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	return n
+}
+
+// merge merges the child at index i+1, along with the key at index i, into the
+// child at index i.
+func (n *btreeNode[K, V]) merge(i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+	left.values = append(append(left.values, n.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+
+	n.keys = removeAt(n.keys, i)
+	n.values = removeAt(n.values, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+// rotateRight moves the key at index i down into the child at index i+1, and
+// replaces it with the greatest key of the child at index i.
+func (n *btreeNode[K, V]) rotateRight(i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	right.keys = insertAt(right.keys, 0, n.keys[i])
+	right.values = insertAt(right.values, 0, n.values[i])
+
+	last := len(left.keys) - 1
+	n.keys[i], n.values[i] = left.keys[last], left.values[last]
+	left.keys = removeAt(left.keys, last)
+	left.values = removeAt(left.values, last)
+
+	if !left.leaf() {
+		right.children = insertAt(right.children, 0, left.children[len(left.children)-1])
+		left.children = removeAt(left.children, len(left.children)-1)
+	}
+}
+
+// rotateLeft moves the key at index i down into the child at index i, and
+// replaces it with the least key of the child at index i+1.
+func (n *btreeNode[K, V]) rotateLeft(i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	left.keys = append(left.keys, n.keys[i])
+	left.values = append(left.values, n.values[i])
+
+	n.keys[i], n.values[i] = right.keys[0], right.values[0]
+	right.keys = removeAt(right.keys, 0)
+	right.values = removeAt(right.values, 0)
+
+	if !right.leaf() {
+		left.children = append(left.children, right.children[0])
+		right.children = removeAt(right.children, 0)
+	}
+}
+
+// insertAt inserts the value into the slice at the provided index.
+func insertAt[T any](slice []T, i int, value T) []T {
+	var zero T
+	slice = append(slice, zero)
+	copy(slice[i+1:], slice[i:])
+	slice[i] = value
+	return slice
+}
+
+// removeAt removes the value at the provided index from the slice.
+func removeAt[T any](slice []T, i int) []T {
+	copy(slice[i:], slice[i+1:])
+
+	var zero T
+	slice[len(slice)-1] = zero
+	return slice[:len(slice)-1]
+}
+
+// btreePosition identifies a single key within a node of a BTreeMap.
+type btreePosition[K constraints.Ordered, V any] struct {
+	node  *btreeNode[K, V]
+	index int
+}
+
+// A btreeCursor is the path from the root of a BTreeMap to the key which it
+// currently points at, which is the key identified by its final position.
+//
+// Each of the earlier positions identifies the key of its node which follows
+// the subtree the path descends into, when the cursor moves forwards, or
+// which precedes it, when the cursor moves backwards. An empty cursor points at
+// nothing.
+type btreeCursor[K constraints.Ordered, V any] []btreePosition[K, V]
+
+// seekFirst returns a forwards cursor pointing at the least key which is not
+// less than the provided key, or which is greater than it if strict is true.
+func seekFirst[K constraints.Ordered, V any](root *btreeNode[K, V], key oxide.Option[K], strict bool) btreeCursor[K, V] {
+	cursor := make(btreeCursor[K, V], 0)
+	for node := root; node != nil; {
+		i := 0
+		if bound, ok := key.Unpack(); ok {
+			i = node.search(bound, strict)
+			if !strict && i < len(node.keys) && node.keys[i] == bound {
+				return append(cursor, btreePosition[K, V]{node: node, index: i})
+			}
+		}
+
+		cursor = append(cursor, btreePosition[K, V]{node: node, index: i})
+		if node.leaf() {
+			break
+		}
+		node = node.children[i]
+	}
+
+	return cursor.normalizeForwards()
+}
+
+// seekLast returns a backwards cursor pointing at the greatest key which is
+// less than the provided key, or which is not greater than it if inclusive is
+// true.
+func seekLast[K constraints.Ordered, V any](root *btreeNode[K, V], key oxide.Option[K], inclusive bool) btreeCursor[K, V] {
+	cursor := make(btreeCursor[K, V], 0)
+	for node := root; node != nil; {
+		i := len(node.keys) - 1
+		if bound, ok := key.Unpack(); ok {
+			i = node.search(bound, inclusive) - 1
+			if inclusive && i >= 0 && node.keys[i] == bound {
+				return append(cursor, btreePosition[K, V]{node: node, index: i})
+			}
+		}
+
+		cursor = append(cursor, btreePosition[K, V]{node: node, index: i})
+		if node.leaf() {
+			break
+		}
+		node = node.children[i+1]
+	}
+
+	return cursor.normalizeBackwards()
+}
+
+// current returns the key and value which the cursor points at.
+func (c btreeCursor[K, V]) current() (K, V) {
+	top := c[len(c)-1]
+	return top.node.keys[top.index], top.node.values[top.index]
+}
+
+// normalizeForwards discards the positions of nodes whose keys have all been
+// visited by a forwards cursor.
+func (c btreeCursor[K, V]) normalizeForwards() btreeCursor[K, V] {
+	for len(c) > 0 && c[len(c)-1].index >= len(c[len(c)-1].node.keys) {
+		c = c[:len(c)-1]
+	}
+
+	return c
+}
+
+// normalizeBackwards discards the positions of nodes whose keys have all been
+// visited by a backwards cursor.
+func (c btreeCursor[K, V]) normalizeBackwards() btreeCursor[K, V] {
+	for len(c) > 0 && c[len(c)-1].index < 0 {
+		c = c[:len(c)-1]
+	}
+
+	return c
+}
+
+// next moves a forwards cursor to the key which follows the current key.
+func (c btreeCursor[K, V]) next() btreeCursor[K, V] {
+	top := &c[len(c)-1]
+	top.index++
+
+	// The keys which follow a key of an internal node are those of the
+	// subtree to its right, starting with the leftmost leaf.
+	if !top.node.leaf() {
+		for node := top.node.children[top.index]; ; node = node.children[0] {
+			c = append(c, btreePosition[K, V]{node: node, index: 0})
+			if node.leaf() {
+				break
+			}
+		}
+	}
+
+	return c.normalizeForwards()
+}
+
+// prev moves a backwards cursor to the key which precedes the current key.
+func (c btreeCursor[K, V]) prev() btreeCursor[K, V] {
+	top := &c[len(c)-1]
+	top.index--
+
+	if !top.node.leaf() {
+		for node := top.node.children[top.index+1]; ; node = node.children[len(node.children)-1] {
+			c = append(c, btreePosition[K, V]{node: node, index: len(node.keys) - 1})
+			if node.leaf() {
+				break
+			}
+		}
+	}
+
+	return c.normalizeBackwards()
+}
+
+type btreeIterator[K constraints.Ordered, V any] struct {
+	front, back btreeCursor[K, V]
+}
+
+func (i *btreeIterator[K, V]) done() bool {
+	if len(i.front) == 0 || len(i.back) == 0 {
+		return true
+	}
+
+	// The iterator is exhausted once the cursors have crossed over.
+	frontKey, _ := i.front.current()
+	backKey, _ := i.back.current()
+	return frontKey > backKey
+}
+
+func (i *btreeIterator[K, V]) Next() (iter.MapEntry[K, V], bool) {
+	if i.done() {
+		i.front, i.back = nil, nil
+		return iter.MapEntry[K, V]{}, false
+	}
+
+	key, value := i.front.current()
+	i.front = i.front.next()
+	return iter.MapEntry[K, V]{Key: key, Val: value}, true
+}
+
+func (i *btreeIterator[K, V]) NextBack() (iter.MapEntry[K, V], bool) {
+	if i.done() {
+		i.front, i.back = nil, nil
+		return iter.MapEntry[K, V]{}, false
+	}
+
+	key, value := i.back.current()
+	i.back = i.back.prev()
+	return iter.MapEntry[K, V]{Key: key, Val: value}, true
+}
+
+// Iter returns a double-ended iterator over the entries of the map, in
+// ascending order of their keys. Use iter.Rev in order to iterate in
+// descending order.
+//
+// The iterator is lazy, and the map must not be modified while it is being
+// consumed.
+func (m *BTreeMap[K, V]) Iter() iter.DoubleEnded[iter.MapEntry[K, V]] {
+	return &btreeIterator[K, V]{
+		front: seekFirst(m.root, oxide.None[K](), false),
+		back:  seekLast(m.root, oxide.None[K](), false),
+	}
+}
+
+// Range returns a double-ended iterator over the entries of the map whose keys
+// are within the half-open range [lo, hi), in ascending order of their keys.
+// The iterator yields no entries if lo is not less than hi.
+//
+// Finding the bounds of the range takes logarithmic time, after which each
+// entry is yielded in amortized constant time. For additional details see
+// Iter.
+func (m *BTreeMap[K, V]) Range(lo, hi K) iter.DoubleEnded[iter.MapEntry[K, V]] {
+	if !(lo < hi) {
+		return &btreeIterator[K, V]{}
+	}
+
+	return &btreeIterator[K, V]{
+		front: seekFirst(m.root, oxide.Some(lo), false),
+		back:  seekLast(m.root, oxide.Some(hi), false),
+	}
+}
+
+// Keys returns a double-ended iterator over the keys of the map, in ascending
+// order. For additional details see Iter.
+func (m *BTreeMap[K, V]) Keys() iter.DoubleEnded[K] {
+	return &btreeKeyIterator[K, V]{inner: m.Iter()}
+}
+
+// entryAt returns the entry which the cursor points at, or a None value if the
+// cursor is empty.
+func entryAt[K constraints.Ordered, V any](cursor btreeCursor[K, V]) oxide.Option[iter.MapEntry[K, V]] {
+	if len(cursor) == 0 {
+		return oxide.None[iter.MapEntry[K, V]]()
+	}
+
+	key, value := cursor.current()
+	return oxide.Some(iter.MapEntry[K, V]{Key: key, Val: value})
+}
+
+// First returns the entry with the least key in the map, or a None value if
+// the map is empty.
+func (m *BTreeMap[K, V]) First() oxide.Option[iter.MapEntry[K, V]] {
+	return entryAt(seekFirst(m.root, oxide.None[K](), false))
+}
+
+// Last returns the entry with the greatest key in the map, or a None value if
+// the map is empty.
+func (m *BTreeMap[K, V]) Last() oxide.Option[iter.MapEntry[K, V]] {
+	return entryAt(seekLast(m.root, oxide.None[K](), false))
+}
+
+// Floor returns the entry with the greatest key which is less than or equal to
+// the provided key, or a None value if there is no such entry.
+func (m *BTreeMap[K, V]) Floor(key K) oxide.Option[iter.MapEntry[K, V]] {
+	return entryAt(seekLast(m.root, oxide.Some(key), true))
+}
+
+// Ceiling returns the entry with the least key which is greater than or equal
+// to the provided key, or a None value if there is no such entry.
+func (m *BTreeMap[K, V]) Ceiling(key K) oxide.Option[iter.MapEntry[K, V]] {
+	return entryAt(seekFirst(m.root, oxide.Some(key), false))
+}
+
+type btreeKeyIterator[K constraints.Ordered, V any] struct {
+	inner iter.DoubleEnded[iter.MapEntry[K, V]]
+}
+
+func (i *btreeKeyIterator[K, V]) Next() (K, bool) {
+	entry, ok := i.inner.Next()
+	return entry.Key, ok
+}
+
+func (i *btreeKeyIterator[K, V]) NextBack() (K, bool) {
+	entry, ok := i.inner.NextBack()
+	return entry.Key, ok
+}
+
+// A BTreeSet is a set which keeps its values sorted, backed by a BTreeMap.
+//
+// The zero value is an empty BTreeSet which is ready for use.
+type BTreeSet[T constraints.Ordered] struct {
+	tree BTreeMap[T, struct{}]
+}
+
+// NewBTreeSet returns a new BTreeSet containing the provided values.
+func NewBTreeSet[T constraints.Ordered](values ...T) *BTreeSet[T] {
+	set := &BTreeSet[T]{}
+	for _, value := range values {
+		set.Insert(value)
+	}
+
+	return set
+}
+
+// CollectBTreeSet consumes the provided iterator, returning a BTreeSet
+// containing every unique value which it yielded.
+func CollectBTreeSet[T constraints.Ordered](iterator iter.Interface[T]) *BTreeSet[T] {
+	set := NewBTreeSet[T]()
+	iter.ForEach(iterator, func(value *T) {
+		set.Insert(*value)
+	})
+
+	return set
+}
+
+// Len returns the number of values in the set.
+func (s *BTreeSet[T]) Len() int {
+	return s.tree.Len()
+}
+
+// Insert adds the provided value to the set, returning true if the value was
+// not already present.
+func (s *BTreeSet[T]) Insert(value T) bool {
+	return s.tree.Set(value, struct{}{})
+}
+
+// Remove removes the provided value from the set, returning true if the value
+// was present.
+func (s *BTreeSet[T]) Remove(value T) bool {
+	return s.tree.Delete(value).IsSome()
+}
+
+// Contains returns true if the provided value is present in the set.
+func (s *BTreeSet[T]) Contains(value T) bool {
+	return s.tree.Contains(value)
+}
+
+// keyOf returns the key of the provided entry, if there is one.
+func keyOf[T constraints.Ordered](entry oxide.Option[iter.MapEntry[T, struct{}]]) oxide.Option[T] {
+	if value, ok := entry.Unpack(); ok {
+		return oxide.Some(value.Key)
+	}
+
+	return oxide.None[T]()
+}
+
+// First returns the least value in the set, or a None value if the set is
+// empty.
+func (s *BTreeSet[T]) First() oxide.Option[T] {
+	return keyOf(s.tree.First())
+}
+
+// Last returns the greatest value in the set, or a None value if the set is
+// empty.
+func (s *BTreeSet[T]) Last() oxide.Option[T] {
+	return keyOf(s.tree.Last())
+}
+
+// Floor returns the greatest value in the set which is less than or equal to
+// the provided value, or a None value if there is no such value.
+func (s *BTreeSet[T]) Floor(value T) oxide.Option[T] {
+	return keyOf(s.tree.Floor(value))
+}
+
+// Ceiling returns the least value in the set which is greater than or equal to
+// the provided value, or a None value if there is no such value.
+func (s *BTreeSet[T]) Ceiling(value T) oxide.Option[T] {
+	return keyOf(s.tree.Ceiling(value))
+}
+
+// Iter returns a double-ended iterator over the values of the set, in
+// ascending order. For additional details see BTreeMap.Iter.
+func (s *BTreeSet[T]) Iter() iter.DoubleEnded[T] {
+	return s.tree.Keys()
+}
+
+// Range returns a double-ended iterator over the values of the set which are
+// within the half-open range [lo, hi), in ascending order. For additional
+// details see BTreeMap.Range.
+func (s *BTreeSet[T]) Range(lo, hi T) iter.DoubleEnded[T] {
+	return &btreeKeyIterator[T, struct{}]{inner: s.tree.Range(lo, hi)}
+}
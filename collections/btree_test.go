@@ -0,0 +1,337 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// checkBTree asserts that every node of the tree holds a valid number of keys,
+// in ascending order, and that every leaf is at the same depth.
+func checkBTree(t *testing.T, m *BTreeMap[int, int]) {
+	t.Helper()
+
+	leafDepth := -1
+	var walk func(node *btreeNode[int, int], depth int, lo, hi oxide.Option[int])
+	walk = func(node *btreeNode[int, int], depth int, lo, hi oxide.Option[int]) {
+		if node != m.root && (len(node.keys) < btreeDegree-1 || len(node.keys) > btreeMaxKeys) {
+			t.Fatalf("node holds %d keys", len(node.keys))
+		}
+
+		assert.Equal(t, len(node.keys), len(node.values))
+		for i, key := range node.keys {
+			if (i > 0 && node.keys[i-1] >= key) || (lo.IsSome() && key <= lo.Value()) || (hi.IsSome() && key >= hi.Value()) {
+				t.Fatalf("key %v is out of order", key)
+			}
+		}
+
+		if node.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			assert.Equal(t, leafDepth, depth)
+			return
+		}
+
+		assert.Equal(t, len(node.keys)+1, len(node.children))
+		for i, child := range node.children {
+			childLo, childHi := lo, hi
+			if i > 0 {
+				childLo = oxide.Some(node.keys[i-1])
+			}
+			if i < len(node.keys) {
+				childHi = oxide.Some(node.keys[i])
+			}
+			walk(child, depth+1, childLo, childHi)
+		}
+	}
+
+	if m.root != nil {
+		walk(m.root, 0, oxide.None[int](), oxide.None[int]())
+	}
+}
+
+func keysOf(iterator iter.Interface[iter.MapEntry[int, int]]) []int {
+	keys := make([]int, 0)
+	iter.ForEach(iterator, func(entry *iter.MapEntry[int, int]) {
+		keys = append(keys, entry.Key)
+	})
+
+	return keys
+}
+
+func TestBTreeMap(t *testing.T) {
+	var m BTreeMap[int, string]
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, oxide.None[string](), m.Get(1))
+	assert.Equal(t, oxide.None[string](), m.Delete(1))
+	assert.Equal(t, oxide.None[iter.MapEntry[int, string]](), m.Last())
+	assert.Equal(t, 0, len(iter.CollectSlice(m.Iter())))
+
+	assert.Equal(t, true, m.Set(2, "two"))
+	assert.Equal(t, true, m.Set(1, "one"))
+	assert.Equal(t, false, m.Set(2, "TWO"))
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, oxide.Some("TWO"), m.Get(2))
+	assert.Equal(t, true, m.Contains(1))
+	assert.Equal(t, false, m.Contains(3))
+
+	assert.Equal(t, oxide.Some("one"), m.Delete(1))
+	assert.Equal(t, oxide.None[string](), m.Delete(1))
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestBTreeMap_Randomized(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	m := NewBTreeMap[int, int]()
+	reference := make(map[int]int)
+
+	for i := 0; i < 20000; i++ {
+		key := random.Intn(2000)
+		if random.Intn(3) == 0 {
+			_, ok := reference[key]
+			assert.Equal(t, ok, m.Delete(key).IsSome())
+			delete(reference, key)
+		} else {
+			_, ok := reference[key]
+			assert.Equal(t, !ok, m.Set(key, i))
+			reference[key] = i
+		}
+	}
+
+	checkBTree(t, m)
+	assert.Equal(t, len(reference), m.Len())
+
+	expect := make([]int, 0, len(reference))
+	for key, value := range reference {
+		expect = append(expect, key)
+		assert.Equal(t, oxide.Some(value), m.Get(key))
+	}
+	sort.Ints(expect)
+
+	assert.Equal(t, expect, keysOf(m.Iter()))
+
+	reversed := keysOf(iter.Rev(m.Iter()))
+	for i := range reversed {
+		assert.Equal(t, expect[len(expect)-1-i], reversed[i])
+	}
+
+	// Drain the map completely, which should collapse it back to a leaf.
+	for _, key := range expect {
+		assert.Equal(t, true, m.Delete(key).IsSome())
+	}
+	checkBTree(t, m)
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, true, m.root.leaf())
+	assert.Equal(t, oxide.None[iter.MapEntry[int, int]](), m.First())
+}
+
+func TestBTreeMap_Range(t *testing.T) {
+	// Only even keys are stored, so that bounds fall both on and between keys.
+	m := NewBTreeMap[int, int]()
+	for i := 0; i < 1000; i += 2 {
+		m.Set(i, i*i)
+	}
+
+	testIO := []struct {
+		name   string
+		lo, hi int
+	}{
+		{name: "should yield a range bounded by keys", lo: 100, hi: 200},
+		{name: "should yield a range bounded between keys", lo: 101, hi: 199},
+		{name: "should yield a range which starts before the first key", lo: -50, hi: 40},
+		{name: "should yield a range which ends after the last key", lo: 960, hi: 5000},
+		{name: "should yield every key", lo: -1, hi: 1000},
+		{name: "should yield a single key", lo: 500, hi: 501},
+		{name: "should yield nothing between adjacent keys", lo: 501, hi: 502},
+		{name: "should yield nothing for an empty range", lo: 300, hi: 300},
+		{name: "should yield nothing for an inverted range", lo: 300, hi: 200},
+		{name: "should yield nothing beyond the last key", lo: 1000, hi: 2000},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			expect := make([]int, 0)
+			for i := 0; i < 1000; i += 2 {
+				if i >= test.lo && i < test.hi {
+					expect = append(expect, i)
+				}
+			}
+
+			assert.Equal(t, expect, keysOf(m.Range(test.lo, test.hi)))
+
+			reversed := make([]int, 0, len(expect))
+			for i := len(expect) - 1; i >= 0; i-- {
+				reversed = append(reversed, expect[i])
+			}
+			assert.Equal(t, reversed, keysOf(iter.Rev(m.Range(test.lo, test.hi))))
+		})
+	}
+}
+
+func TestBTreeMap_RangeDoubleEnded(t *testing.T) {
+	m := NewBTreeMap[int, int]()
+	for i := 0; i < 200; i++ {
+		m.Set(i, i)
+	}
+
+	// Alternate between both ends until the cursors meet in the middle.
+	iterator := m.Range(10, 21)
+	actual := make([]int, 0)
+	for {
+		front, ok := iterator.Next()
+		if !ok {
+			break
+		}
+		actual = append(actual, front.Key)
+
+		back, ok := iterator.NextBack()
+		if !ok {
+			break
+		}
+		actual = append(actual, back.Key)
+	}
+
+	assert.Equal(t, []int{10, 20, 11, 19, 12, 18, 13, 17, 14, 16, 15}, actual)
+
+	_, ok := iterator.NextBack()
+	assert.Equal(t, false, ok)
+}
+
+func TestBTreeMap_Bounds(t *testing.T) {
+	m := NewBTreeMap[int, string]()
+	for i := 10; i <= 1000; i += 10 {
+		m.Set(i, "")
+	}
+
+	entry := func(key int) oxide.Option[iter.MapEntry[int, string]] {
+		return oxide.Some(iter.MapEntry[int, string]{Key: key})
+	}
+	none := oxide.None[iter.MapEntry[int, string]]()
+
+	assert.Equal(t, entry(10), m.First())
+	assert.Equal(t, entry(1000), m.Last())
+
+	testIO := []struct {
+		name    string
+		key     int
+		floor   oxide.Option[iter.MapEntry[int, string]]
+		ceiling oxide.Option[iter.MapEntry[int, string]]
+	}{
+		{name: "should find a present key", key: 500, floor: entry(500), ceiling: entry(500)},
+		{name: "should find the neighbours of an absent key", key: 505, floor: entry(500), ceiling: entry(510)},
+		{name: "should find nothing below the first key", key: 5, floor: none, ceiling: entry(10)},
+		{name: "should find nothing above the last key", key: 1005, floor: entry(1000), ceiling: none},
+		{name: "should find the first key", key: 10, floor: entry(10), ceiling: entry(10)},
+		{name: "should find the last key", key: 1000, floor: entry(1000), ceiling: entry(1000)},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.floor, m.Floor(test.key))
+			assert.Equal(t, test.ceiling, m.Ceiling(test.key))
+		})
+	}
+}
+
+func TestBTreeSet(t *testing.T) {
+	var set BTreeSet[string]
+	assert.Equal(t, oxide.None[string](), set.First())
+
+	set = *NewBTreeSet("pear", "fig", "apple", "kiwi", "fig")
+	assert.Equal(t, 4, set.Len())
+	assert.Equal(t, false, set.Insert("kiwi"))
+	assert.Equal(t, true, set.Contains("pear"))
+	assert.Equal(t, true, set.Remove("pear"))
+	assert.Equal(t, false, set.Remove("pear"))
+
+	assert.Equal(t, []string{"apple", "fig", "kiwi"}, iter.CollectSlice(set.Iter()))
+	assert.Equal(t, []string{"kiwi", "fig"}, iter.CollectSlice[string](iter.Rev(set.Range("b", "z"))))
+	assert.Equal(t, oxide.Some("apple"), set.First())
+	assert.Equal(t, oxide.Some("kiwi"), set.Last())
+	assert.Equal(t, oxide.Some("fig"), set.Floor("grape"))
+	assert.Equal(t, oxide.Some("kiwi"), set.Ceiling("grape"))
+	assert.Equal(t, oxide.None[string](), set.Ceiling("lime"))
+}
+
+func TestCollectBTree(t *testing.T) {
+	words := []string{"pear", "fig", "apple", "fig"}
+
+	m := CollectBTreeMap(iter.FromSlice(words), func(word string) (int, string) {
+		return len(word), word
+	})
+	assert.Equal(t, []iter.MapEntry[int, string]{{Key: 3, Val: "fig"}, {Key: 4, Val: "pear"}, {Key: 5, Val: "apple"}}, iter.CollectSlice(m.Iter()))
+
+	set := CollectBTreeSet(iter.FromSlice(words))
+	assert.Equal(t, []string{"apple", "fig", "pear"}, iter.CollectSlice(set.Iter()))
+}
+
+func benchmarkBTreeKeys() []int {
+	random := rand.New(rand.NewSource(42))
+	return random.Perm(10000)
+}
+
+func BenchmarkBTreeMap_SortedIter(b *testing.B) {
+	keys := benchmarkBTreeKeys()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m := NewBTreeMap[int, int]()
+		for _, key := range keys {
+			m.Set(key, key)
+		}
+		_ = iter.CollectSlice(m.Iter())
+	}
+}
+
+func BenchmarkMapSort_SortedIter(b *testing.B) {
+	keys := benchmarkBTreeKeys()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m := make(map[int]int)
+		for _, key := range keys {
+			m[key] = key
+		}
+		_ = iter.CollectSlice(iter.SortedByKey(iter.FromMap(m), func(e iter.MapEntry[int, int]) int {
+			return e.Key
+		}))
+	}
+}
+
+func BenchmarkBTreeMap_Range(b *testing.B) {
+	keys := benchmarkBTreeKeys()
+	m := NewBTreeMap[int, int]()
+	for _, key := range keys {
+		m.Set(key, key)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		lo := i % 9900
+		_ = iter.CollectSlice(m.Range(lo, lo+100))
+	}
+}
+
+func BenchmarkMapSort_Range(b *testing.B) {
+	keys := benchmarkBTreeKeys()
+	m := make(map[int]int)
+	for _, key := range keys {
+		m[key] = key
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		lo := i % 9900
+		inRange := iter.Filter(iter.FromMap(m), func(e *iter.MapEntry[int, int]) bool {
+			return e.Key >= lo && e.Key < lo+100
+		})
+		_ = iter.CollectSlice(iter.SortedByKey(inRange, func(e iter.MapEntry[int, int]) int {
+			return e.Key
+		}))
+	}
+}
@@ -0,0 +1,295 @@
+package collections
+
+import (
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// minDequeCapacity is the capacity a Deque grows to when an element is first
+// pushed onto it.
+const minDequeCapacity = 8
+
+// A Deque is a double-ended queue which supports pushing and popping elements
+// at both its front and its back in amortized constant time, as well as
+// constant time access to any element by its index.
+//
+// It is backed by a ring buffer which doubles in size whenever it is full.
+//
+// The zero value is an empty Deque which is ready for use.
+type Deque[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+// NewDeque returns a new Deque containing the provided values, from front to
+// back.
+func NewDeque[T any](values ...T) *Deque[T] {
+	deque := &Deque[T]{data: make([]T, max(len(values), minDequeCapacity))}
+	for _, value := range values {
+		deque.PushBack(value)
+	}
+
+	return deque
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// index returns the position within the underlying buffer of the element at
+// the provided logical index.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.data)
+}
+
+// grow doubles the capacity of the underlying buffer if it is full, moving
+// the elements so that the front of the deque is at the start of the buffer.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.data) {
+		return
+	}
+
+	data := make([]T, max(2*len(d.data), minDequeCapacity))
+	for i := 0; i < d.size; i++ {
+		data[i] = d.data[d.index(i)]
+	}
+
+	d.data = data
+	d.head = 0
+}
+
+// PushFront adds the provided value to the front of the deque.
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.data)) % len(d.data)
+	d.data[d.head] = value
+	d.size++
+}
+
+// PushBack adds the provided value to the back of the deque.
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.data[d.index(d.size)] = value
+	d.size++
+}
+
+// PopFront removes and returns the element at the front of the deque, or a
+// None value if the deque is empty.
+func (d *Deque[T]) PopFront() oxide.Option[T] {
+	if d.size == 0 {
+		return oxide.None[T]()
+	}
+
+	var zero T
+	value := d.data[d.head]
+	d.data[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return oxide.Some(value)
+}
+
+// PopBack removes and returns the element at the back of the deque, or a None
+// value if the deque is empty.
+func (d *Deque[T]) PopBack() oxide.Option[T] {
+	if d.size == 0 {
+		return oxide.None[T]()
+	}
+
+	var zero T
+	index := d.index(d.size - 1)
+	value := d.data[index]
+	d.data[index] = zero
+	d.size--
+	return oxide.Some(value)
+}
+
+// Get returns the element at the provided index, where the front of the deque
+// is at index 0, or a None value if the index is out of range.
+func (d *Deque[T]) Get(i int) oxide.Option[T] {
+	if i < 0 || i >= d.size {
+		return oxide.None[T]()
+	}
+
+	return oxide.Some(d.data[d.index(i)])
+}
+
+// Rotate rotates the elements of the deque n steps towards the back, such
+// that the last n elements are moved to the front. A negative n rotates the
+// elements towards the front instead, moving the first -n elements to the
+// back.
+//
+// Rotating takes time proportional to the lesser of n and Len() - n.
+func (d *Deque[T]) Rotate(n int) {
+	if d.size == 0 {
+		return
+	}
+
+	n %= d.size
+	if n < 0 {
+		n += d.size
+	}
+
+	if n <= d.size/2 {
+		for ; n > 0; n-- {
+			d.PushFront(d.PopBack().Value())
+		}
+	} else {
+		for n = d.size - n; n > 0; n-- {
+			d.PushBack(d.PopFront().Value())
+		}
+	}
+}
+
+type dequeIterator[T any] struct {
+	deque       *Deque[T]
+	front, back int
+}
+
+func (i *dequeIterator[T]) Next() (T, bool) {
+	if i.front >= i.back {
+		var zero T
+		return zero, false
+	}
+
+	i.front++
+	return i.deque.data[i.deque.index(i.front-1)], true
+}
+
+func (i *dequeIterator[T]) NextBack() (T, bool) {
+	if i.front >= i.back {
+		var zero T
+		return zero, false
+	}
+
+	i.back--
+	return i.deque.data[i.deque.index(i.back)], true
+}
+
+func (i *dequeIterator[T]) Len() int {
+	return i.back - i.front
+}
+
+func (i *dequeIterator[T]) SizeHint() (int64, oxide.Option[int64]) {
+	return int64(i.Len()), oxide.Some(int64(i.Len()))
+}
+
+// Iter returns a double-ended iterator over the elements of the deque, from
+// front to back, without modifying the deque. Use iter.Rev in order to
+// iterate from back to front.
+//
+// The deque must not be modified while the iterator is being consumed.
+func (d *Deque[T]) Iter() iter.DoubleEnded[T] {
+	return &dequeIterator[T]{deque: d, back: d.size}
+}
+
+type dequeDrainIterator[T any] struct {
+	deque *Deque[T]
+}
+
+func (i *dequeDrainIterator[T]) Next() (T, bool) {
+	return i.deque.PopFront().Unpack()
+}
+
+func (i *dequeDrainIterator[T]) NextBack() (T, bool) {
+	return i.deque.PopBack().Unpack()
+}
+
+func (i *dequeDrainIterator[T]) Len() int {
+	return i.deque.Len()
+}
+
+func (i *dequeDrainIterator[T]) SizeHint() (int64, oxide.Option[int64]) {
+	return int64(i.Len()), oxide.Some(int64(i.Len()))
+}
+
+// Drain returns a double-ended iterator which lazily removes the elements of
+// the deque as they are yielded. Next pops elements from the front of the
+// deque and NextBack pops them from the back.
+func (d *Deque[T]) Drain() iter.DoubleEnded[T] {
+	return &dequeDrainIterator[T]{deque: d}
+}
+
+// A RingBuffer is a fixed capacity queue which, once full, overwrites its
+// oldest element whenever a new element is pushed. This makes it well suited
+// to keeping a bounded history of the most recent values of a stream.
+type RingBuffer[T any] struct {
+	deque    Deque[T]
+	capacity int
+}
+
+// NewRingBuffer returns a new, empty RingBuffer which holds at most capacity
+// elements. A RingBuffer with a capacity of zero or less never holds any
+// elements.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	capacity = max(capacity, 0)
+	return &RingBuffer[T]{deque: Deque[T]{data: make([]T, capacity)}, capacity: capacity}
+}
+
+// Len returns the number of elements in the buffer.
+func (b *RingBuffer[T]) Len() int {
+	return b.deque.Len()
+}
+
+// Cap returns the maximum number of elements which the buffer holds.
+func (b *RingBuffer[T]) Cap() int {
+	return b.capacity
+}
+
+// IsFull returns true if the buffer holds as many elements as its capacity,
+// in which case the next push overwrites the oldest element.
+func (b *RingBuffer[T]) IsFull() bool {
+	return b.deque.Len() == b.capacity
+}
+
+// Push adds the provided value as the newest element of the buffer. If the
+// buffer was full, the oldest element is removed and returned to make room for
+// it, otherwise a None value is returned.
+func (b *RingBuffer[T]) Push(value T) oxide.Option[T] {
+	if b.capacity == 0 {
+		return oxide.Some(value)
+	}
+
+	evicted := oxide.None[T]()
+	if b.IsFull() {
+		evicted = b.deque.PopFront()
+	}
+
+	b.deque.PushBack(value)
+	return evicted
+}
+
+// PopOldest removes and returns the oldest element of the buffer, or a None
+// value if the buffer is empty.
+func (b *RingBuffer[T]) PopOldest() oxide.Option[T] {
+	return b.deque.PopFront()
+}
+
+// PopNewest removes and returns the newest element of the buffer, or a None
+// value if the buffer is empty.
+func (b *RingBuffer[T]) PopNewest() oxide.Option[T] {
+	return b.deque.PopBack()
+}
+
+// Get returns the element at the provided index, where the oldest element is
+// at index 0, or a None value if the index is out of range.
+func (b *RingBuffer[T]) Get(i int) oxide.Option[T] {
+	return b.deque.Get(i)
+}
+
+// Iter returns a double-ended iterator over the elements of the buffer, from
+// oldest to newest, without modifying the buffer. Use iter.Rev in order to
+// iterate from newest to oldest.
+//
+// The buffer must not be modified while the iterator is being consumed.
+func (b *RingBuffer[T]) Iter() iter.DoubleEnded[T] {
+	return b.deque.Iter()
+}
+
+// Drain returns a double-ended iterator which lazily removes the elements of
+// the buffer as they are yielded. Next pops the oldest elements and NextBack
+// pops the newest.
+func (b *RingBuffer[T]) Drain() iter.DoubleEnded[T] {
+	return b.deque.Drain()
+}
@@ -0,0 +1,129 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestDeque(t *testing.T) {
+	var deque Deque[int]
+	assert.Equal(t, 0, deque.Len())
+	assert.Equal(t, oxide.None[int](), deque.PopFront())
+	assert.Equal(t, oxide.None[int](), deque.PopBack())
+	assert.Equal(t, oxide.None[int](), deque.Get(0))
+
+	// Push enough elements to both ends that the buffer wraps and grows.
+	for i := 1; i <= 10; i++ {
+		deque.PushBack(i)
+		deque.PushFront(-i)
+	}
+
+	assert.Equal(t, 20, deque.Len())
+	assert.Equal(t, oxide.Some(-10), deque.Get(0))
+	assert.Equal(t, oxide.Some(10), deque.Get(19))
+	assert.Equal(t, oxide.Some(1), deque.Get(10))
+	assert.Equal(t, oxide.None[int](), deque.Get(20))
+	assert.Equal(t, oxide.None[int](), deque.Get(-1))
+
+	assert.Equal(t, oxide.Some(-10), deque.PopFront())
+	assert.Equal(t, oxide.Some(10), deque.PopBack())
+	assert.Equal(t, []int{-9, -8, -7, -6, -5, -4, -3, -2, -1, 1, 2, 3, 4, 5, 6, 7, 8, 9}, iter.CollectSlice[int](deque.Iter()))
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	testIO := []struct {
+		name   string
+		n      int
+		expect []int
+	}{
+		{name: "should not rotate by zero", n: 0, expect: []int{1, 2, 3, 4, 5}},
+		{name: "should rotate towards the back", n: 2, expect: []int{4, 5, 1, 2, 3}},
+		{name: "should rotate by more than half", n: 4, expect: []int{2, 3, 4, 5, 1}},
+		{name: "should rotate towards the front", n: -1, expect: []int{2, 3, 4, 5, 1}},
+		{name: "should rotate by the length", n: 5, expect: []int{1, 2, 3, 4, 5}},
+		{name: "should rotate by more than the length", n: -7, expect: []int{3, 4, 5, 1, 2}},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			deque := NewDeque(1, 2, 3, 4, 5)
+			deque.Rotate(test.n)
+			assert.Equal(t, test.expect, iter.CollectSlice[int](deque.Iter()))
+		})
+	}
+
+	var empty Deque[int]
+	empty.Rotate(3)
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestDeque_Iter(t *testing.T) {
+	deque := NewDeque(1, 2, 3, 4, 5)
+	deque.Rotate(2)
+
+	values := deque.Iter()
+	lower, upper := iter.SizeHint[int](values)
+	assert.Equal(t, int64(5), lower)
+	assert.Equal(t, oxide.Some(int64(5)), upper)
+
+	first, _ := values.Next()
+	last, _ := values.NextBack()
+	assert.Equal(t, 4, first)
+	assert.Equal(t, 3, last)
+	assert.Equal(t, 3, values.(iter.ExactSizer).Len())
+	assert.Equal(t, []int{2, 1, 5}, iter.CollectSlice[int](iter.Rev(values)))
+	assert.Equal(t, 5, deque.Len())
+
+	drain := deque.Drain()
+	first, _ = drain.Next()
+	last, _ = drain.NextBack()
+	assert.Equal(t, 4, first)
+	assert.Equal(t, 3, last)
+	assert.Equal(t, 3, deque.Len())
+	assert.Equal(t, []int{2, 1, 5}, iter.CollectSlice[int](iter.Rev(drain)))
+	assert.Equal(t, 0, deque.Len())
+}
+
+func TestRingBuffer(t *testing.T) {
+	buffer := NewRingBuffer[int](3)
+	assert.Equal(t, 3, buffer.Cap())
+	assert.Equal(t, false, buffer.IsFull())
+
+	assert.Equal(t, oxide.None[int](), buffer.Push(1))
+	assert.Equal(t, oxide.None[int](), buffer.Push(2))
+	assert.Equal(t, oxide.None[int](), buffer.Push(3))
+	assert.Equal(t, true, buffer.IsFull())
+
+	// Once full, each push evicts the oldest element.
+	assert.Equal(t, oxide.Some(1), buffer.Push(4))
+	assert.Equal(t, oxide.Some(2), buffer.Push(5))
+	assert.Equal(t, 3, buffer.Len())
+	assert.Equal(t, oxide.Some(3), buffer.Get(0))
+	assert.Equal(t, []int{3, 4, 5}, iter.CollectSlice[int](buffer.Iter()))
+	assert.Equal(t, []int{5, 4, 3}, iter.CollectSlice[int](iter.Rev(buffer.Iter())))
+
+	assert.Equal(t, oxide.Some(5), buffer.PopNewest())
+	assert.Equal(t, oxide.Some(3), buffer.PopOldest())
+	assert.Equal(t, oxide.None[int](), buffer.Push(6))
+	assert.Equal(t, []int{4, 6}, iter.CollectSlice[int](buffer.Drain()))
+	assert.Equal(t, 0, buffer.Len())
+
+	// The capacity of the buffer never grows.
+	for i := 0; i < 100; i++ {
+		buffer.Push(i)
+	}
+	assert.Equal(t, 3, buffer.Len())
+	assert.Equal(t, 3, len(buffer.deque.data))
+}
+
+func TestRingBuffer_ZeroCapacity(t *testing.T) {
+	buffer := NewRingBuffer[string](-1)
+	assert.Equal(t, 0, buffer.Cap())
+	assert.Equal(t, true, buffer.IsFull())
+	assert.Equal(t, oxide.Some("a"), buffer.Push("a"))
+	assert.Equal(t, 0, buffer.Len())
+	assert.Equal(t, oxide.None[string](), buffer.PopOldest())
+}
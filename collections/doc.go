@@ -0,0 +1,10 @@
+// Package collections provides generic data structures which integrate with
+// the iterator APIs provided by the iter module.
+//
+// Every collection can be traversed via an iter.Interface, and can be built
+// up from one via the collectors which accompany it, such as CollectSet.
+// These collectors live alongside the collections rather than in the iter
+// module in order to avoid an import cycle between the two packages.
+//
+// Unless stated otherwise, the collections are not safe for concurrent use.
+package collections
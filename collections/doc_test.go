@@ -0,0 +1,109 @@
+package collections_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/moogar0880/oxide/collections"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func ExampleSet_Intersection() {
+	{
+		// Collect the unique values yielded by our pre-defined data.
+		evens := collections.CollectSet(iter.FromSlice([]int{2, 4, 6, 8, 10, 12}))
+		triples := collections.NewSet(3, 6, 9, 12)
+
+		common := collections.CollectSet(evens.Intersection(triples))
+		fmt.Println(iter.CollectSlice(common.SortedIter(func(a, b int) bool {
+			return a < b
+		})))
+	}
+	// Output: [6 12]
+}
+
+func ExampleOrderedMap_MarshalJSON() {
+	{
+		// Define an ordered map whose keys are not sorted.
+		config := collections.NewOrderedMap[string, any]()
+		config.Set("name", "oxide")
+		config.Set("version", 2)
+		config.Set("features", []string{"iter", "collections"})
+
+		data, _ := json.Marshal(config)
+		fmt.Println(string(data))
+	}
+	// Output: {"name":"oxide","version":2,"features":["iter","collections"]}
+}
+
+func ExampleIndexedPriorityQueue() {
+	type task struct {
+		name     string
+		priority int
+	}
+
+	{
+		// Schedule tasks with the lowest priority value first.
+		queue := collections.NewIndexedPriorityQueue(func(a, b task) bool {
+			return a.priority < b.priority
+		})
+
+		queue.Push(task{"compile", 2})
+		tests := queue.Push(task{"test", 3})
+		queue.Push(task{"lint", 1})
+
+		// Promote the tests ahead of every other task.
+		queue.Update(tests, task{"test", 0})
+
+		iter.ForEach(queue.IntoSortedIter(), func(t *task) {
+			fmt.Println(t.name)
+		})
+	}
+	// Output:
+	// test
+	// lint
+	// compile
+}
+
+func ExampleRingBuffer() {
+	{
+		// Keep a history of the three most recent commands.
+		history := collections.NewRingBuffer[string](3)
+		for _, command := range []string{"ls", "cd", "make", "git status"} {
+			history.Push(command)
+		}
+
+		// Print the history from the newest command to the oldest.
+		iter.ForEach[string](iter.Rev(history.Iter()), func(command *string) {
+			fmt.Println(*command)
+		})
+	}
+	// Output:
+	// git status
+	// make
+	// cd
+}
+
+func ExampleBTreeMap_Range() {
+	{
+		// Index a set of events by the minute of the day at which they occur.
+		events := collections.NewBTreeMap[int, string]()
+		events.Set(9*60, "standup")
+		events.Set(12*60+30, "lunch")
+		events.Set(8*60+15, "commute")
+		events.Set(15*60, "review")
+
+		// Print the events which occur before noon, latest first.
+		iter.ForEach(iter.Rev(events.Range(0, 12*60)), func(entry *iter.MapEntry[int, string]) {
+			fmt.Printf("%02d:%02d %s\n", entry.Key/60, entry.Key%60, entry.Val)
+		})
+
+		// Find the next event after the standup.
+		next, _ := events.Ceiling(9*60 + 1).Unpack()
+		fmt.Println(next.Val)
+	}
+	// Output:
+	// 09:00 standup
+	// 08:15 commute
+	// lunch
+}
@@ -0,0 +1,388 @@
+package collections
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// orderedEntry is a node of the doubly linked list which records the order of
+// the entries of an OrderedMap.
+type orderedEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *orderedEntry[K, V]
+
+	// removed marks entries which have been deleted from the map, so that
+	// iterators which still reference them know to skip past them.
+	removed bool
+}
+
+// An OrderedMap is a map which remembers the order in which its keys were
+// inserted. Keys, Values and Entries yield in that order, and entries can be
+// reordered via MoveToFront and MoveToBack.
+//
+// Get, Set, Delete, MoveToFront and MoveToBack all run in constant time.
+//
+// The zero value is an empty OrderedMap which is ready for use. An OrderedMap
+// must not be copied after first use.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedEntry[K, V]
+
+	// root is the sentinel of the circular list of entries: root.next is the
+	// first entry, and root.prev is the last.
+	root orderedEntry[K, V]
+}
+
+// NewOrderedMap returns a new, empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return new(OrderedMap[K, V]).init()
+}
+
+// CollectOrderedMap consumes the provided Interface, converting each yielded
+// value into a key-value pair that are inserted into the generated
+// OrderedMap, in the order in which they were yielded.
+//
+// If the same key is yielded more than once, the entry keeps the position at
+// which the key was first yielded and the value which was yielded last.
+func CollectOrderedMap[K comparable, V any](iterator iter.Interface[V], fn iter.MapEntryFunc[K, V]) *OrderedMap[K, V] {
+	out := NewOrderedMap[K, V]()
+	iter.ForEach(iterator, func(item *V) {
+		out.Set(fn(*item))
+	})
+
+	return out
+}
+
+func (m *OrderedMap[K, V]) init() *OrderedMap[K, V] {
+	if m.entries == nil {
+		m.entries = make(map[K]*orderedEntry[K, V])
+		m.root.next = &m.root
+		m.root.prev = &m.root
+	}
+
+	return m
+}
+
+// Len returns the number of entries in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Get returns the value associated with the provided key, or a None value if
+// the key is not present in the map.
+func (m *OrderedMap[K, V]) Get(key K) oxide.Option[V] {
+	if entry, ok := m.entries[key]; ok {
+		return oxide.Some(entry.value)
+	}
+
+	return oxide.None[V]()
+}
+
+// Contains returns true if the provided key is present in the map.
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Set associates the provided value with the provided key, returning true if
+// the key was not already present. New keys are added to the back of the map,
+// whereas existing keys keep their position.
+func (m *OrderedMap[K, V]) Set(key K, value V) bool {
+	if entry, ok := m.entries[key]; ok {
+		entry.value = value
+		return false
+	}
+
+	m.init()
+
+	entry := &orderedEntry[K, V]{key: key, value: value}
+	m.entries[key] = entry
+	m.insertBefore(entry, &m.root)
+	return true
+}
+
+// Delete removes the entry with the provided key from the map, returning true
+// if the key was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	delete(m.entries, key)
+	m.unlink(entry)
+	entry.removed = true
+	return true
+}
+
+// MoveToFront moves the entry with the provided key to the front of the map,
+// returning true if the key was present.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(entry)
+	m.insertBefore(entry, m.root.next)
+	return true
+}
+
+// MoveToBack moves the entry with the provided key to the back of the map,
+// returning true if the key was present.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(entry)
+	m.insertBefore(entry, &m.root)
+	return true
+}
+
+// unlink removes the entry from the list of entries. The entry's own links are
+// left intact so that any iterator which is positioned on it can continue.
+func (m *OrderedMap[K, V]) unlink(entry *orderedEntry[K, V]) {
+	entry.prev.next = entry.next
+	entry.next.prev = entry.prev
+}
+
+// insertBefore links the entry into the list of entries immediately before the
+// provided mark.
+func (m *OrderedMap[K, V]) insertBefore(entry, mark *orderedEntry[K, V]) {
+	entry.prev = mark.prev
+	entry.next = mark
+	mark.prev.next = entry
+	mark.prev = entry
+}
+
+type orderedMapIterator[K comparable, V any] struct {
+	m    *OrderedMap[K, V]
+	next *orderedEntry[K, V]
+}
+
+func (i *orderedMapIterator[K, V]) Next() (iter.MapEntry[K, V], bool) {
+	for i.next != nil && i.next.removed {
+		i.next = i.next.next
+	}
+
+	if i.next == nil || i.next == &i.m.root {
+		i.next = nil
+
+		var zero iter.MapEntry[K, V]
+		return zero, false
+	}
+
+	entry := i.next
+	i.next = entry.next
+	return iter.MapEntry[K, V]{Key: entry.key, Val: entry.value}, true
+}
+
+// Entries returns an iterator over the key-value pairs of the map, in order.
+//
+// The iterator is lazy, and reflects changes made to the map while it is being
+// consumed: entries which are deleted before they are reached are skipped,
+// and entries which are added to the back of the map are yielded. Entries
+// which are moved while the iterator is being consumed may be skipped or
+// yielded twice.
+func (m *OrderedMap[K, V]) Entries() iter.Interface[iter.MapEntry[K, V]] {
+	// A zero value map is not initialized here, so that reading from it does
+	// not race with other readers. Entries which are added to it later are
+	// therefore not yielded.
+	if m.entries == nil {
+		return iter.FromSlice([]iter.MapEntry[K, V]{})
+	}
+
+	return &orderedMapIterator[K, V]{m: m, next: m.root.next}
+}
+
+// Keys returns an iterator over the keys of the map, in order. For additional
+// details see Entries.
+func (m *OrderedMap[K, V]) Keys() iter.Interface[K] {
+	return iter.Map(m.Entries(), func(entry iter.MapEntry[K, V]) K {
+		return entry.Key
+	})
+}
+
+// Values returns an iterator over the values of the map, in order. For
+// additional details see Entries.
+func (m *OrderedMap[K, V]) Values() iter.Interface[V] {
+	return iter.Map(m.Entries(), func(entry iter.MapEntry[K, V]) V {
+		return entry.Val
+	})
+}
+
+// MarshalJSON implements json.Marshaler, encoding the map as a JSON object
+// whose members are in the same order as the entries of the map.
+//
+// Keys are encoded following the same rules as encoding/json uses for maps:
+// the key type must either be a string, implement encoding.TextMarshaler, or
+// be an integer.
+//
+// Note: MarshalJSON has a pointer receiver, as an OrderedMap must not be
+// copied, so encoding/json only uses it for addressable values. An OrderedMap
+// which is stored by value within a struct encodes as {} unless a pointer to
+// the struct is marshaled; store a *OrderedMap instead.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	entries := m.Entries()
+	for index := 0; ; index++ {
+		entry, ok := entries.Next()
+		if !ok {
+			break
+		}
+
+		if index > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := marshalKey(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(entry.Val)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the map
+// with the members of a JSON object in the order in which they appear.
+//
+// If a key appears more than once, the entry keeps the position at which the
+// key first appeared and the value which appeared last.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		*m = OrderedMap[K, V]{}
+		return nil
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("collections: cannot unmarshal %v into an OrderedMap", token)
+	}
+
+	// Members are decoded in full before the map is modified, so that the map
+	// is left unchanged if the data is invalid.
+	members := make([]iter.MapEntry[K, V], 0)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, err := unmarshalKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+
+		members = append(members, iter.MapEntry[K, V]{Key: key, Val: value})
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	*m = OrderedMap[K, V]{}
+	for _, member := range members {
+		m.Set(member.Key, member.Val)
+	}
+
+	return nil
+}
+
+// marshalKey encodes the provided key as a quoted JSON string, in the same
+// manner as encoding/json encodes the keys of a map: keys which implement
+// encoding.TextMarshaler are encoded with it, even if they are strings.
+func marshalKey[K comparable](key K) ([]byte, error) {
+	value := reflect.ValueOf(key)
+	if marshaler, ok := any(key).(encoding.TextMarshaler); ok {
+		if value.Kind() == reflect.Pointer && value.IsNil() {
+			return json.Marshal("")
+		}
+
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(string(text))
+	}
+
+	if value.Kind() == reflect.String {
+		return json.Marshal(value.String())
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Marshal(strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return json.Marshal(strconv.FormatUint(value.Uint(), 10))
+	}
+
+	return nil, fmt.Errorf("collections: unsupported OrderedMap key type %T", key)
+}
+
+// unmarshalKey decodes a key which was encoded by marshalKey, in the same
+// manner as encoding/json decodes the keys of a map: keys which implement
+// encoding.TextUnmarshaler are decoded with it, even if they are strings.
+func unmarshalKey[K comparable](text string) (K, error) {
+	var key K
+	if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText([]byte(text))
+		return key, err
+	}
+
+	value := reflect.ValueOf(&key).Elem()
+	if value.Kind() == reflect.String {
+		value.SetString(text)
+		return key, nil
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("collections: invalid OrderedMap key %q: %w", text, err)
+		}
+		value.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("collections: invalid OrderedMap key %q: %w", text, err)
+		}
+		value.SetUint(n)
+		return key, nil
+	}
+
+	return key, fmt.Errorf("collections: unsupported OrderedMap key type %T", key)
+}
@@ -0,0 +1,203 @@
+package collections
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestOrderedMap(t *testing.T) {
+	var m OrderedMap[string, int]
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, oxide.None[int](), m.Get("a"))
+	assert.Equal(t, false, m.Delete("a"))
+	assert.Equal(t, []string{}, iter.CollectSlice(m.Keys()))
+
+	assert.Equal(t, true, m.Set("c", 3))
+	assert.Equal(t, true, m.Set("a", 1))
+	assert.Equal(t, true, m.Set("b", 2))
+	assert.Equal(t, false, m.Set("a", 10))
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, oxide.Some(10), m.Get("a"))
+	assert.Equal(t, true, m.Contains("b"))
+	assert.Equal(t, []string{"c", "a", "b"}, iter.CollectSlice(m.Keys()))
+	assert.Equal(t, []int{3, 10, 2}, iter.CollectSlice(m.Values()))
+
+	assert.Equal(t, true, m.Delete("a"))
+	assert.Equal(t, false, m.Contains("a"))
+	assert.Equal(t, []iter.MapEntry[string, int]{{Key: "c", Val: 3}, {Key: "b", Val: 2}}, iter.CollectSlice(m.Entries()))
+
+	// Deleted keys are added to the back once they are set again.
+	m.Set("a", 1)
+	assert.Equal(t, []string{"c", "b", "a"}, iter.CollectSlice(m.Keys()))
+}
+
+func TestOrderedMap_Move(t *testing.T) {
+	m := CollectOrderedMap(iter.FromSlice([]string{"a", "b", "c", "d"}), func(s string) (string, string) {
+		return s, strings.ToUpper(s)
+	})
+
+	assert.Equal(t, true, m.MoveToFront("c"))
+	assert.Equal(t, []string{"c", "a", "b", "d"}, iter.CollectSlice(m.Keys()))
+
+	assert.Equal(t, true, m.MoveToBack("a"))
+	assert.Equal(t, []string{"c", "b", "d", "a"}, iter.CollectSlice(m.Keys()))
+
+	assert.Equal(t, true, m.MoveToFront("c"))
+	assert.Equal(t, true, m.MoveToBack("a"))
+	assert.Equal(t, []string{"c", "b", "d", "a"}, iter.CollectSlice(m.Keys()))
+
+	assert.Equal(t, false, m.MoveToFront("z"))
+	assert.Equal(t, false, m.MoveToBack("z"))
+	assert.Equal(t, []string{"C", "B", "D", "A"}, iter.CollectSlice(m.Values()))
+}
+
+func TestOrderedMap_ModifiedWhileIterating(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for i := 0; i < 5; i++ {
+		m.Set(i, i)
+	}
+
+	keys := m.Keys()
+	first, _ := keys.Next()
+	assert.Equal(t, 0, first)
+
+	// Deleting the most recently yielded key, and one which has yet to be
+	// reached, does not interrupt the iterator.
+	m.Delete(0)
+	m.Delete(2)
+	m.Set(5, 5)
+
+	assert.Equal(t, []int{1, 3, 4, 5}, iter.CollectSlice(keys))
+}
+
+func TestCollectOrderedMap(t *testing.T) {
+	m := CollectOrderedMap(iter.FromSlice([]int{3, 1, 3, 2}), func(i int) (int, int) {
+		return i % 3, i
+	})
+	assert.Equal(t, []int{0, 1, 2}, iter.CollectSlice(m.Keys()))
+	assert.Equal(t, []int{3, 1, 2}, iter.CollectSlice(m.Values()))
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	testIO := []struct {
+		name string
+		json string
+	}{
+		{name: "should handle an empty object", json: `{}`},
+		{name: "should preserve the order of members", json: `{"zebra":1,"apple":{"nested":[1,2]},"mango":null}`},
+		{name: "should escape keys", json: `{"quote\"d":1,"\u003chtml\u003e":2}`},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			m := NewOrderedMap[string, any]()
+			assert.Equal(t, nil, json.Unmarshal([]byte(test.json), m))
+
+			actual, err := json.Marshal(m)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.json, string(actual))
+		})
+	}
+}
+
+func TestOrderedMap_JSONKeys(t *testing.T) {
+	ints := NewOrderedMap[int8, bool]()
+	assert.Equal(t, nil, json.Unmarshal([]byte(`{"5":true,"-3":false,"5":false}`), ints))
+	assert.Equal(t, []int8{5, -3}, iter.CollectSlice(ints.Keys()))
+	assert.Equal(t, []bool{false, false}, iter.CollectSlice(ints.Values()))
+
+	actual, err := json.Marshal(ints)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"5":false,"-3":false}`, string(actual))
+
+	// Invalid keys leave the map unchanged.
+	assert.Equal(t, true, json.Unmarshal([]byte(`{"1":true,"300":true}`), ints) != nil)
+	assert.Equal(t, []int8{5, -3}, iter.CollectSlice(ints.Keys()))
+
+	addrs := NewOrderedMap[netip.Addr, int]()
+	assert.Equal(t, nil, json.Unmarshal([]byte(`{"10.0.0.2":2,"10.0.0.1":1}`), addrs))
+	actual, err = json.Marshal(addrs)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"10.0.0.2":2,"10.0.0.1":1}`, string(actual))
+
+	floats := NewOrderedMap[float64, int]()
+	floats.Set(1.5, 1)
+	_, err = json.Marshal(floats)
+	assert.Equal(t, true, err != nil)
+
+	// Embedding an OrderedMap within another type preserves its order.
+	type document struct {
+		Fields *OrderedMap[string, int] `json:"fields"`
+	}
+
+	doc := document{Fields: NewOrderedMap[string, int]()}
+	doc.Fields.Set("b", 1)
+	doc.Fields.Set("a", 2)
+	actual, err = json.Marshal(doc)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"fields":{"b":1,"a":2}}`, string(actual))
+}
+
+// upperKey is a string key type with custom text encoding methods.
+type upperKey string
+
+func (k upperKey) MarshalText() ([]byte, error) {
+	return []byte(strings.ToUpper(string(k))), nil
+}
+
+func (k *upperKey) UnmarshalText(text []byte) error {
+	*k = upperKey(strings.ToLower(string(text)))
+	return nil
+}
+
+func TestOrderedMap_JSONTextKeys(t *testing.T) {
+	// Text encoding methods take precedence over the string kind of a key,
+	// just as they do for the keys of a map.
+	ordered := NewOrderedMap[upperKey, int]()
+	ordered.Set("a", 1)
+
+	actual, err := json.Marshal(ordered)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"A":1}`, string(actual))
+
+	assert.Equal(t, nil, json.Unmarshal([]byte(`{"B":2}`), ordered))
+	assert.Equal(t, []iter.MapEntry[upperKey, int]{{Key: "b", Val: 2}}, iter.CollectSlice(ordered.Entries()))
+}
+
+func TestOrderedMap_JSONByValue(t *testing.T) {
+	type document struct {
+		Fields OrderedMap[string, int] `json:"fields"`
+	}
+
+	var doc document
+	doc.Fields.Set("b", 1)
+	doc.Fields.Set("a", 2)
+
+	// MarshalJSON is only used when the map is addressable.
+	actual, err := json.Marshal(&doc)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"fields":{"b":1,"a":2}}`, string(actual))
+
+	actual, err = json.Marshal(doc)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"fields":{}}`, string(actual))
+}
+
+func TestOrderedMap_ZeroValueReadOnly(t *testing.T) {
+	var m OrderedMap[string, int]
+	assert.Equal(t, 0, len(iter.CollectSlice(m.Entries())))
+
+	actual, err := json.Marshal(&m)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{}`, string(actual))
+
+	// Reading from the zero value must not initialize it.
+	assert.Equal(t, true, m.entries == nil)
+}
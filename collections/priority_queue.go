@@ -0,0 +1,247 @@
+package collections
+
+import (
+	"container/heap"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/internal/lessheap"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// A PriorityQueue is a collection which always yields its least element first,
+// according to the sorting function it was created with. It is backed by a
+// binary heap, so Push and Pop run in logarithmic time and Peek runs in
+// constant time.
+//
+// Elements which are equal according to the sorting function are popped in an
+// unspecified order.
+type PriorityQueue[T any] struct {
+	heap lessheap.Heap[T]
+}
+
+// NewPriorityQueue returns a new PriorityQueue which orders its elements
+// according to the provided sorting function, containing the provided values.
+//
+// The queue is built from the values in linear time, which is cheaper than
+// pushing each of them in turn.
+func NewPriorityQueue[T any](lessFunc func(a, b T) bool, values ...T) *PriorityQueue[T] {
+	data := append(make([]T, 0, len(values)), values...)
+	queue := &PriorityQueue[T]{heap: lessheap.Heap[T]{LessFunc: lessFunc, Data: data}}
+	heap.Init(&queue.heap)
+	return queue
+}
+
+// CollectHeap consumes the provided iterator into a new PriorityQueue which
+// orders its elements according to the provided sorting function.
+func CollectHeap[T any](iterator iter.Interface[T], lessFunc func(a, b T) bool) *PriorityQueue[T] {
+	queue := &PriorityQueue[T]{heap: lessheap.Heap[T]{LessFunc: lessFunc, Data: iter.CollectSlice(iterator)}}
+	heap.Init(&queue.heap)
+	return queue
+}
+
+// Len returns the number of elements in the queue.
+func (q *PriorityQueue[T]) Len() int {
+	return q.heap.Len()
+}
+
+// Push adds the provided value to the queue.
+func (q *PriorityQueue[T]) Push(value T) {
+	heap.Push(&q.heap, value)
+}
+
+// Pop removes and returns the least element of the queue, or a None value if
+// the queue is empty.
+func (q *PriorityQueue[T]) Pop() oxide.Option[T] {
+	if q.heap.Len() == 0 {
+		return oxide.None[T]()
+	}
+
+	return oxide.Some(heap.Pop(&q.heap).(T))
+}
+
+// Peek returns the least element of the queue without removing it, or a None
+// value if the queue is empty.
+func (q *PriorityQueue[T]) Peek() oxide.Option[T] {
+	if q.heap.Len() == 0 {
+		return oxide.None[T]()
+	}
+
+	return oxide.Some(q.heap.Data[0])
+}
+
+// PushPop adds the provided value to the queue and then removes and returns
+// the least element of the queue.
+//
+// This is more efficient than calling Push followed by Pop. If the provided
+// value is not greater than the least element of the queue, it is returned
+// immediately without modifying the queue.
+func (q *PriorityQueue[T]) PushPop(value T) T {
+	if q.heap.Len() == 0 || !q.heap.LessFunc(q.heap.Data[0], value) {
+		return value
+	}
+
+	least := q.heap.Data[0]
+	q.heap.Data[0] = value
+	heap.Fix(&q.heap, 0)
+	return least
+}
+
+// Iter returns an iterator over the elements of the queue, in an unspecified
+// order, without modifying the queue.
+//
+// The iterator yields the elements which were in the queue at the time Iter
+// was called, so the queue may be safely modified while it is consumed.
+func (q *PriorityQueue[T]) Iter() iter.Interface[T] {
+	return iter.FromSlice(append([]T(nil), q.heap.Data...))
+}
+
+// poppable is implemented by both PriorityQueue and IndexedPriorityQueue.
+type poppable[T any] interface {
+	Len() int
+	Pop() oxide.Option[T]
+}
+
+type drainingIterator[T any] struct {
+	queue poppable[T]
+}
+
+func (i *drainingIterator[T]) Next() (T, bool) {
+	return i.queue.Pop().Unpack()
+}
+
+func (i *drainingIterator[T]) SizeHint() (int64, oxide.Option[int64]) {
+	return int64(i.queue.Len()), oxide.Some(int64(i.queue.Len()))
+}
+
+// IntoSortedIter returns an iterator which lazily pops every element of the
+// queue, yielding them in ascending order according to the queue's sorting
+// function.
+//
+// The queue is drained as the iterator is consumed: each element yielded is
+// removed from the queue, and elements pushed in the meantime are yielded in
+// their proper order.
+func (q *PriorityQueue[T]) IntoSortedIter() iter.Interface[T] {
+	return &drainingIterator[T]{queue: q}
+}
+
+// A Handle refers to an element of an IndexedPriorityQueue, and can be used to
+// update or remove that element regardless of its position in the queue.
+type Handle[T any] struct {
+	value T
+
+	// index is the position of the element in the queue's heap, or -1 once
+	// the element has been removed from the queue.
+	index int
+}
+
+// Value returns the value of the element referred to by the handle.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// An IndexedPriorityQueue is a PriorityQueue which returns a Handle for each
+// element pushed onto it. A handle allows its element to be updated or removed
+// in logarithmic time, which is useful for algorithms such as Dijkstra's
+// shortest path, or for rescheduling tasks.
+type IndexedPriorityQueue[T any] struct {
+	heap lessheap.Heap[*Handle[T]]
+}
+
+// NewIndexedPriorityQueue returns a new, empty IndexedPriorityQueue which
+// orders its elements according to the provided sorting function.
+func NewIndexedPriorityQueue[T any](lessFunc func(a, b T) bool) *IndexedPriorityQueue[T] {
+	return &IndexedPriorityQueue[T]{heap: lessheap.Heap[*Handle[T]]{
+		LessFunc: func(a, b *Handle[T]) bool {
+			return lessFunc(a.value, b.value)
+		},
+		Data: make([]*Handle[T], 0),
+		Moved: func(handle *Handle[T], index int) {
+			handle.index = index
+		},
+	}}
+}
+
+// Len returns the number of elements in the queue.
+func (q *IndexedPriorityQueue[T]) Len() int {
+	return q.heap.Len()
+}
+
+// Push adds the provided value to the queue, returning a Handle which refers
+// to it.
+func (q *IndexedPriorityQueue[T]) Push(value T) *Handle[T] {
+	handle := &Handle[T]{value: value}
+	heap.Push(&q.heap, handle)
+	return handle
+}
+
+// Pop removes and returns the least element of the queue, or a None value if
+// the queue is empty. The element's handle is no longer valid.
+func (q *IndexedPriorityQueue[T]) Pop() oxide.Option[T] {
+	if q.heap.Len() == 0 {
+		return oxide.None[T]()
+	}
+
+	handle := heap.Pop(&q.heap).(*Handle[T])
+	handle.index = -1
+	return oxide.Some(handle.value)
+}
+
+// Peek returns the least element of the queue without removing it, or a None
+// value if the queue is empty.
+func (q *IndexedPriorityQueue[T]) Peek() oxide.Option[T] {
+	if q.heap.Len() == 0 {
+		return oxide.None[T]()
+	}
+
+	return oxide.Some(q.heap.Data[0].value)
+}
+
+// Contains returns true if the element referred to by the provided handle is
+// still in the queue.
+func (q *IndexedPriorityQueue[T]) Contains(handle *Handle[T]) bool {
+	return handle.index >= 0 && handle.index < q.heap.Len() && q.heap.Data[handle.index] == handle
+}
+
+// Update replaces the value of the element referred to by the provided handle,
+// and restores its position in the queue. It returns false if the element is
+// no longer in the queue.
+func (q *IndexedPriorityQueue[T]) Update(handle *Handle[T], value T) bool {
+	if !q.Contains(handle) {
+		return false
+	}
+
+	handle.value = value
+	heap.Fix(&q.heap, handle.index)
+	return true
+}
+
+// Remove removes the element referred to by the provided handle from the
+// queue, returning its value, or a None value if the element is no longer in
+// the queue.
+func (q *IndexedPriorityQueue[T]) Remove(handle *Handle[T]) oxide.Option[T] {
+	if !q.Contains(handle) {
+		return oxide.None[T]()
+	}
+
+	heap.Remove(&q.heap, handle.index)
+	handle.index = -1
+	return oxide.Some(handle.value)
+}
+
+// Iter returns an iterator over the elements of the queue, in an unspecified
+// order, without modifying the queue. For additional details see
+// PriorityQueue.Iter.
+func (q *IndexedPriorityQueue[T]) Iter() iter.Interface[T] {
+	values := make([]T, len(q.heap.Data))
+	for index, handle := range q.heap.Data {
+		values[index] = handle.value
+	}
+
+	return iter.FromSlice(values)
+}
+
+// IntoSortedIter returns an iterator which lazily pops every element of the
+// queue. For additional details see PriorityQueue.IntoSortedIter.
+func (q *IndexedPriorityQueue[T]) IntoSortedIter() iter.Interface[T] {
+	return &drainingIterator[T]{queue: q}
+}
@@ -0,0 +1,148 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	queue := NewPriorityQueue(lessInt)
+	assert.Equal(t, 0, queue.Len())
+	assert.Equal(t, oxide.None[int](), queue.Peek())
+	assert.Equal(t, oxide.None[int](), queue.Pop())
+
+	for _, value := range []int{5, 1, 4, 1, 3} {
+		queue.Push(value)
+	}
+
+	assert.Equal(t, 5, queue.Len())
+	assert.Equal(t, oxide.Some(1), queue.Peek())
+	assert.Equal(t, oxide.Some(1), queue.Pop())
+	assert.Equal(t, oxide.Some(1), queue.Pop())
+	assert.Equal(t, oxide.Some(3), queue.Peek())
+	assert.Equal(t, 3, queue.Len())
+	assert.Equal(t, []int{3, 4, 5}, sorted(queue.Iter()))
+	assert.Equal(t, 3, queue.Len())
+}
+
+func TestPriorityQueue_PushPop(t *testing.T) {
+	testIO := []struct {
+		name      string
+		data      []int
+		value     int
+		expect    int
+		remaining []int
+	}{
+		{name: "should return the value from an empty queue", data: []int{}, value: 3, expect: 3, remaining: []int{}},
+		{name: "should return a lesser value", data: []int{2, 4}, value: 1, expect: 1, remaining: []int{2, 4}},
+		{name: "should return an equal value", data: []int{2, 4}, value: 2, expect: 2, remaining: []int{2, 4}},
+		{name: "should return the least element", data: []int{2, 4}, value: 3, expect: 2, remaining: []int{3, 4}},
+		{name: "should return the least element", data: []int{2, 4}, value: 5, expect: 2, remaining: []int{4, 5}},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			queue := NewPriorityQueue(lessInt, test.data...)
+			assert.Equal(t, test.expect, queue.PushPop(test.value))
+			assert.Equal(t, test.remaining, iter.CollectSlice(queue.IntoSortedIter()))
+		})
+	}
+}
+
+func TestCollectHeap(t *testing.T) {
+	data := rand.New(rand.NewSource(1)).Perm(1000)
+	queue := CollectHeap(iter.FromSlice(data), func(a, b int) bool { return a > b })
+
+	drained := queue.IntoSortedIter()
+	lower, upper := iter.SizeHint(drained)
+	assert.Equal(t, int64(1000), lower)
+	assert.Equal(t, oxide.Some(int64(1000)), upper)
+
+	actual := iter.CollectSlice(drained)
+	assert.Equal(t, true, sort.SliceIsSorted(actual, func(i, j int) bool { return actual[i] > actual[j] }))
+	assert.Equal(t, 1000, len(actual))
+	assert.Equal(t, 0, queue.Len())
+}
+
+func TestNewPriorityQueue_CopiesValues(t *testing.T) {
+	data := []int{3, 2, 1}
+	queue := NewPriorityQueue(lessInt, data...)
+	queue.Pop()
+	assert.Equal(t, []int{3, 2, 1}, data)
+}
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	queue := NewIndexedPriorityQueue(lessInt)
+	assert.Equal(t, oxide.None[int](), queue.Peek())
+	assert.Equal(t, oxide.None[int](), queue.Pop())
+
+	handles := make(map[int]*Handle[int])
+	for _, value := range []int{50, 10, 40, 20, 30} {
+		handles[value] = queue.Push(value)
+	}
+	assert.Equal(t, 5, queue.Len())
+	assert.Equal(t, oxide.Some(10), queue.Peek())
+
+	// Updating an element moves it to its new position in the queue.
+	assert.Equal(t, true, queue.Update(handles[50], 5))
+	assert.Equal(t, 5, handles[50].Value())
+	assert.Equal(t, oxide.Some(5), queue.Peek())
+	assert.Equal(t, true, queue.Update(handles[10], 45))
+
+	assert.Equal(t, oxide.Some(40), queue.Remove(handles[40]))
+	assert.Equal(t, false, queue.Contains(handles[40]))
+	assert.Equal(t, oxide.None[int](), queue.Remove(handles[40]))
+	assert.Equal(t, false, queue.Update(handles[40], 1))
+
+	assert.Equal(t, []int{5, 20, 30, 45}, sorted(queue.Iter()))
+	assert.Equal(t, oxide.Some(5), queue.Pop())
+	assert.Equal(t, false, queue.Contains(handles[50]))
+	assert.Equal(t, []int{20, 30, 45}, iter.CollectSlice(queue.IntoSortedIter()))
+
+	// Handles from another queue are never contained.
+	other := NewIndexedPriorityQueue(lessInt)
+	foreign := other.Push(1)
+	queue.Push(2)
+	assert.Equal(t, false, queue.Contains(foreign))
+	assert.Equal(t, false, queue.Update(foreign, 3))
+}
+
+func TestIndexedPriorityQueue_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	queue := NewIndexedPriorityQueue(lessInt)
+	expect := make(map[*Handle[int]]int)
+
+	for round := 0; round < 2000; round++ {
+		switch op := random.Intn(4); {
+		case op < 2 || len(expect) == 0:
+			value := random.Intn(1000)
+			expect[queue.Push(value)] = value
+		case op == 2:
+			for handle := range expect {
+				value := random.Intn(1000)
+				assert.Equal(t, true, queue.Update(handle, value))
+				expect[handle] = value
+				break
+			}
+		default:
+			for handle, value := range expect {
+				assert.Equal(t, oxide.Some(value), queue.Remove(handle))
+				delete(expect, handle)
+				break
+			}
+		}
+	}
+
+	values := make([]int, 0, len(expect))
+	for _, value := range expect {
+		values = append(values, value)
+	}
+	sort.Ints(values)
+
+	assert.Equal(t, values, iter.CollectSlice(queue.IntoSortedIter()))
+}
@@ -0,0 +1,168 @@
+package collections
+
+import (
+	"sort"
+
+	"github.com/moogar0880/oxide/iter"
+)
+
+// A Set is an unordered collection of unique values.
+//
+// The zero value is an empty Set which is ready for use.
+type Set[T comparable] struct {
+	data map[T]struct{}
+}
+
+// NewSet returns a new Set containing the provided values.
+func NewSet[T comparable](values ...T) *Set[T] {
+	set := &Set[T]{data: make(map[T]struct{}, len(values))}
+	for _, value := range values {
+		set.data[value] = struct{}{}
+	}
+
+	return set
+}
+
+// CollectSet consumes the provided iterator, returning a Set containing every
+// unique value which it yielded.
+func CollectSet[T comparable](iterator iter.Interface[T]) *Set[T] {
+	set := NewSet[T]()
+	iter.ForEach(iterator, func(value *T) {
+		set.data[*value] = struct{}{}
+	})
+
+	return set
+}
+
+// Insert adds the provided value to the Set, returning true if the value was
+// not already present.
+func (s *Set[T]) Insert(value T) bool {
+	if s.Contains(value) {
+		return false
+	}
+
+	if s.data == nil {
+		s.data = make(map[T]struct{})
+	}
+
+	s.data[value] = struct{}{}
+	return true
+}
+
+// Remove removes the provided value from the Set, returning true if the value
+// was present.
+func (s *Set[T]) Remove(value T) bool {
+	if !s.Contains(value) {
+		return false
+	}
+
+	delete(s.data, value)
+	return true
+}
+
+// Contains returns true if the provided value is present in the Set.
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.data[value]
+	return ok
+}
+
+// Len returns the number of values in the Set.
+func (s *Set[T]) Len() int {
+	return len(s.data)
+}
+
+// Iter returns an iterator over the values in the Set.
+//
+// Note: Just like when iterating through a map's data using a "for / range",
+// the order in which the values are yielded by the iterator is
+// non-deterministic. Use SortedIter in order to yield the values in a
+// deterministic order.
+//
+// Go maps can not be traversed incrementally, so Iter copies the values of the
+// Set when it is called, in linear time. The iterator yields the values which
+// were present in the Set at that time, so the Set may be safely modified
+// while it is consumed.
+func (s *Set[T]) Iter() iter.Interface[T] {
+	values := make([]T, 0, len(s.data))
+	for value := range s.data {
+		values = append(values, value)
+	}
+
+	return iter.FromSlice(values)
+}
+
+// SortedIter returns an iterator over the values in the Set, sorted according
+// to the provided sorting function.
+func (s *Set[T]) SortedIter(lessFunc func(a, b T) bool) iter.Interface[T] {
+	values := iter.CollectSlice(s.Iter())
+	sort.Slice(values, func(i, j int) bool {
+		return lessFunc(values[i], values[j])
+	})
+
+	return iter.FromSlice(values)
+}
+
+// distinct returns an iterator which yields each value of the provided
+// iterator the first time it is yielded, and skips it thereafter.
+func distinct[T comparable](iterator iter.Interface[T]) iter.Interface[T] {
+	var seen Set[T]
+	return iter.Filter(iterator, func(value *T) bool {
+		return seen.Insert(*value)
+	})
+}
+
+// Union returns an iterator which yields every value which is present in
+// either this Set or the other Set, without duplicates.
+//
+// The values of both Sets are copied when Union is called, as described by
+// Iter, and duplicates are discarded as the iterator is consumed. Changes made
+// to either Set in the meantime are therefore not reflected in the values
+// yielded.
+func (s *Set[T]) Union(other *Set[T]) iter.Interface[T] {
+	return distinct(iter.Chain(s.Iter(), other.Iter()))
+}
+
+// Intersection returns an iterator which yields every value which is present
+// in both this Set and the other Set.
+//
+// The values of the smaller Set are copied when Intersection is called, as
+// described by Iter, and are then checked for membership in the larger Set
+// lazily as the iterator is consumed. Changes made to the larger Set in the
+// meantime are therefore reflected in the values yielded.
+func (s *Set[T]) Intersection(other *Set[T]) iter.Interface[T] {
+	// Iterating over the smaller of the two sets minimizes the number of
+	// values which need to be copied and looked up.
+	smaller, larger := s, other
+	if larger.Len() < smaller.Len() {
+		smaller, larger = larger, smaller
+	}
+
+	return iter.Filter(smaller.Iter(), func(value *T) bool {
+		return larger.Contains(*value)
+	})
+}
+
+// Difference returns an iterator which yields every value which is present in
+// this Set but not in the other Set.
+//
+// The values of this Set are copied when Difference is called, as described
+// by Iter, and are then checked for membership in the other Set lazily as the
+// iterator is consumed. Changes made to the other Set in the meantime are
+// therefore reflected in the values yielded.
+func (s *Set[T]) Difference(other *Set[T]) iter.Interface[T] {
+	return iter.Filter(s.Iter(), func(value *T) bool {
+		return !other.Contains(*value)
+	})
+}
+
+// SymmetricDifference returns an iterator which yields every value which is
+// present in exactly one of this Set and the other Set, without duplicates.
+//
+// The values of both Sets are copied when SymmetricDifference is called, and
+// are checked for membership in the opposite Set lazily. For additional
+// details see Difference.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) iter.Interface[T] {
+	// A value which is removed from both Sets while the iterator is consumed
+	// may pass both membership checks, so duplicates are discarded.
+	return distinct(iter.Chain(s.Difference(other), other.Difference(s)))
+}
@@ -0,0 +1,136 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func lessInt(a, b int) bool { return a < b }
+
+// sorted collects the provided iterator into a sorted slice, so that the
+// values yielded by a Set can be compared deterministically.
+func sorted(iterator iter.Interface[int]) []int {
+	return iter.CollectSlice(iter.Sorted(iterator, lessInt))
+}
+
+func TestSet(t *testing.T) {
+	var set Set[int]
+	assert.Equal(t, 0, set.Len())
+	assert.Equal(t, false, set.Contains(1))
+	assert.Equal(t, false, set.Remove(1))
+
+	assert.Equal(t, true, set.Insert(1))
+	assert.Equal(t, true, set.Insert(2))
+	assert.Equal(t, false, set.Insert(1))
+	assert.Equal(t, 2, set.Len())
+	assert.Equal(t, true, set.Contains(1))
+
+	assert.Equal(t, true, set.Remove(1))
+	assert.Equal(t, false, set.Contains(1))
+	assert.Equal(t, []int{2}, iter.CollectSlice(set.Iter()))
+}
+
+func TestSet_Iter(t *testing.T) {
+	set := NewSet(5, 3, 1, 4, 2, 3)
+	assert.Equal(t, 5, set.Len())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, iter.CollectSlice(set.SortedIter(lessInt)))
+
+	// The iterator is unaffected by changes made to the set after it was
+	// created.
+	values := set.Iter()
+	set.Insert(6)
+	set.Remove(1)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, sorted(values))
+}
+
+func TestCollectSet(t *testing.T) {
+	set := CollectSet(iter.FromSlice([]string{"b", "a", "b", "c", "a"}))
+	assert.Equal(t, []string{"a", "b", "c"}, iter.CollectSlice(set.SortedIter(func(a, b string) bool {
+		return a < b
+	})))
+}
+
+func TestSet_Algebra(t *testing.T) {
+	testIO := []struct {
+		name                string
+		a, b                []int
+		union               []int
+		intersection        []int
+		difference          []int
+		symmetricDifference []int
+	}{
+		{
+			name:                "should handle empty sets",
+			a:                   []int{},
+			b:                   []int{},
+			union:               []int{},
+			intersection:        []int{},
+			difference:          []int{},
+			symmetricDifference: []int{},
+		},
+		{
+			name:                "should handle disjoint sets",
+			a:                   []int{1, 2},
+			b:                   []int{3, 4},
+			union:               []int{1, 2, 3, 4},
+			intersection:        []int{},
+			difference:          []int{1, 2},
+			symmetricDifference: []int{1, 2, 3, 4},
+		},
+		{
+			name:                "should handle overlapping sets",
+			a:                   []int{1, 2, 3, 4},
+			b:                   []int{3, 4, 5},
+			union:               []int{1, 2, 3, 4, 5},
+			intersection:        []int{3, 4},
+			difference:          []int{1, 2},
+			symmetricDifference: []int{1, 2, 5},
+		},
+		{
+			name:                "should handle a subset",
+			a:                   []int{2},
+			b:                   []int{1, 2, 3},
+			union:               []int{1, 2, 3},
+			intersection:        []int{2},
+			difference:          []int{},
+			symmetricDifference: []int{1, 3},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := NewSet(test.a...), NewSet(test.b...)
+
+			assert.Equal(t, test.union, sorted(a.Union(b)))
+			assert.Equal(t, test.union, sorted(b.Union(a)))
+			assert.Equal(t, test.intersection, sorted(a.Intersection(b)))
+			assert.Equal(t, test.intersection, sorted(b.Intersection(a)))
+			assert.Equal(t, test.difference, sorted(a.Difference(b)))
+			assert.Equal(t, test.symmetricDifference, sorted(a.SymmetricDifference(b)))
+			assert.Equal(t, test.symmetricDifference, sorted(b.SymmetricDifference(a)))
+		})
+	}
+}
+
+func TestSet_AlgebraUnderMutation(t *testing.T) {
+	a, b := NewSet(1, 2, 3), NewSet(2, 3, 4)
+
+	// Removing the shared values from both sets part way through must not
+	// cause them to be yielded twice.
+	union := a.Union(b)
+	symmetric := a.SymmetricDifference(b)
+	for _, value := range []int{2, 3} {
+		a.Remove(value)
+		b.Remove(value)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, sorted(union))
+	assert.Equal(t, []int{1, 2, 3, 4}, sorted(symmetric))
+
+	// Membership in the other set is checked lazily.
+	difference := a.Difference(b)
+	b.Insert(1)
+	assert.Equal(t, []int{}, sorted(difference))
+}
@@ -0,0 +1,120 @@
+package comparator
+
+import (
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+)
+
+// A Comparator is a function which compares two values, a and b, returning
+// oxide.Less if a should be ordered before b, oxide.Greater if a should be
+// ordered after b, and oxide.Equal otherwise.
+type Comparator[T any] func(a, b T) oxide.Ordering
+
+// Compare is a Comparator which compares two values using their natural
+// ordering, as defined by oxide.Compare.
+func Compare[T constraints.Ordered](a, b T) oxide.Ordering {
+	return oxide.Compare(a, b)
+}
+
+// Reverse returns a Comparator which orders values in the opposite order of
+// the provided Comparator.
+func Reverse[T any](comparator Comparator[T]) Comparator[T] {
+	return func(a, b T) oxide.Ordering {
+		return comparator(a, b).Reverse()
+	}
+}
+
+// ThenBy returns a Comparator which compares values using the first provided
+// Comparator, falling back to each of the subsequent Comparators in turn for
+// as long as the values compare as equal.
+func ThenBy[T any](first Comparator[T], rest ...Comparator[T]) Comparator[T] {
+	return func(a, b T) oxide.Ordering {
+		ordering := first(a, b)
+		for _, comparator := range rest {
+			if ordering != oxide.Equal {
+				break
+			}
+			ordering = comparator(a, b)
+		}
+
+		return ordering
+	}
+}
+
+// ByKey returns a Comparator which orders values by the natural ordering of
+// the key extracted from each value by the provided function.
+func ByKey[T any, K constraints.Ordered](key func(T) K) Comparator[T] {
+	return ByKeyWith(key, Compare[K])
+}
+
+// ByKeyWith returns a Comparator which orders values by comparing the key
+// extracted from each value by the provided function using the provided
+// Comparator.
+func ByKeyWith[T, K any](key func(T) K, comparator Comparator[K]) Comparator[T] {
+	return func(a, b T) oxide.Ordering {
+		return comparator(key(a), key(b))
+	}
+}
+
+// NilsFirst returns a Comparator over pointers which orders nil pointers
+// before any non-nil pointer. Non-nil pointers are ordered by comparing the
+// values which they point to using the provided Comparator.
+func NilsFirst[T any](comparator Comparator[T]) Comparator[*T] {
+	return func(a, b *T) oxide.Ordering {
+		switch {
+		case a == nil && b == nil:
+			return oxide.Equal
+		case a == nil:
+			return oxide.Less
+		case b == nil:
+			return oxide.Greater
+		default:
+			return comparator(*a, *b)
+		}
+	}
+}
+
+// NilsLast returns a Comparator over pointers which orders nil pointers after
+// any non-nil pointer. Non-nil pointers are ordered by comparing the values
+// which they point to using the provided Comparator.
+func NilsLast[T any](comparator Comparator[T]) Comparator[*T] {
+	nilsFirst := NilsFirst(Reverse(comparator))
+	return func(a, b *T) oxide.Ordering {
+		return nilsFirst(a, b).Reverse()
+	}
+}
+
+// OptionCompare returns a Comparator over Options which orders None values
+// before any Some value. Some values are ordered by comparing their inner
+// values using the provided Comparator.
+func OptionCompare[T any](comparator Comparator[T]) Comparator[oxide.Option[T]] {
+	return func(a, b oxide.Option[T]) oxide.Ordering {
+		switch {
+		case a.IsNone() && b.IsNone():
+			return oxide.Equal
+		case a.IsNone():
+			return oxide.Less
+		case b.IsNone():
+			return oxide.Greater
+		default:
+			return comparator(a.Value(), b.Value())
+		}
+	}
+}
+
+// Less adapts the provided Comparator into a function which reports whether a
+// is ordered before b, as accepted by iter.Sorted and related functions.
+func Less[T any](comparator Comparator[T]) func(a, b T) bool {
+	return func(a, b T) bool {
+		return comparator(a, b) == oxide.Less
+	}
+}
+
+// CmpFunc adapts the provided Comparator into a function which returns a
+// negative number, zero or a positive number, as accepted by iter.SortedByCmp
+// and related functions.
+func CmpFunc[T any](comparator Comparator[T]) func(a, b T) int {
+	return func(a, b T) int {
+		return int(comparator(a, b))
+	}
+}
@@ -0,0 +1,129 @@
+package comparator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+var people = []person{
+	{"carol", 35}, {"alice", 30}, {"bob", 30}, {"dave", 25},
+}
+
+func names(data []person) []string {
+	out := make([]string, len(data))
+	for index, item := range data {
+		out[index] = item.name
+	}
+
+	return out
+}
+
+func TestCompare(t *testing.T) {
+	testIO := []struct {
+		name   string
+		a, b   float64
+		expect oxide.Ordering
+	}{
+		{name: "less", a: 1, b: 2, expect: oxide.Less},
+		{name: "equal", a: 2, b: 2, expect: oxide.Equal},
+		{name: "greater", a: 3, b: 2, expect: oxide.Greater},
+		{name: "both NaN", a: math.NaN(), b: math.NaN(), expect: oxide.Equal},
+		{name: "NaN before negative infinity", a: math.NaN(), b: math.Inf(-1), expect: oxide.Less},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Compare(test.a, test.b))
+			assert.Equal(t, test.expect.Reverse(), Reverse(Compare[float64])(test.a, test.b))
+		})
+	}
+
+	assert.Equal(t, oxide.Less, Compare("apple", "banana"))
+}
+
+func TestThenBy(t *testing.T) {
+	byAge := ByKey(func(p person) int { return p.age })
+	byName := ByKey(func(p person) string { return p.name })
+
+	testIO := []struct {
+		name       string
+		comparator Comparator[person]
+		expect     []string
+	}{
+		{
+			name:       "single comparator",
+			comparator: ThenBy(byName),
+			expect:     []string{"alice", "bob", "carol", "dave"},
+		},
+		{
+			name:       "age then name",
+			comparator: ThenBy(byAge, byName),
+			expect:     []string{"dave", "alice", "bob", "carol"},
+		},
+		{
+			name:       "age descending then name",
+			comparator: ThenBy(Reverse(byAge), byName),
+			expect:     []string{"carol", "alice", "bob", "dave"},
+		},
+		{
+			name:       "age then name descending",
+			comparator: ThenBy(byAge, Reverse(byName)),
+			expect:     []string{"dave", "bob", "alice", "carol"},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			sorted := iter.Sorted(iter.FromSlice(people), Less(test.comparator))
+			assert.Equal(t, test.expect, names(iter.CollectSlice(sorted)))
+
+			sorted = iter.SortedByCmp(iter.FromSlice(people), CmpFunc(test.comparator))
+			assert.Equal(t, test.expect, names(iter.CollectSlice(sorted)))
+		})
+	}
+}
+
+func TestNils(t *testing.T) {
+	one, two := 1, 2
+	data := []*int{&two, nil, &one, nil}
+
+	deref := func(data []*int) []int {
+		out := make([]int, len(data))
+		for index, item := range data {
+			out[index] = -1
+			if item != nil {
+				out[index] = *item
+			}
+		}
+
+		return out
+	}
+
+	sorted := iter.SortedStable(iter.FromSlice(data), Less(NilsFirst(Compare[int])))
+	assert.Equal(t, []int{-1, -1, 1, 2}, deref(iter.CollectSlice(sorted)))
+
+	sorted = iter.SortedStable(iter.FromSlice(data), Less(NilsLast(Compare[int])))
+	assert.Equal(t, []int{1, 2, -1, -1}, deref(iter.CollectSlice(sorted)))
+
+	sorted = iter.SortedStable(iter.FromSlice(data), Less(NilsLast(Reverse(Compare[int]))))
+	assert.Equal(t, []int{2, 1, -1, -1}, deref(iter.CollectSlice(sorted)))
+}
+
+func TestOptionCompare(t *testing.T) {
+	comparator := OptionCompare(Compare[int])
+
+	assert.Equal(t, oxide.Equal, comparator(oxide.None[int](), oxide.None[int]()))
+	assert.Equal(t, oxide.Less, comparator(oxide.None[int](), oxide.Some(-10)))
+	assert.Equal(t, oxide.Greater, comparator(oxide.Some(-10), oxide.None[int]()))
+	assert.Equal(t, oxide.Less, comparator(oxide.Some(1), oxide.Some(2)))
+	assert.Equal(t, oxide.Equal, comparator(oxide.Some(2), oxide.Some(2)))
+}
@@ -0,0 +1,9 @@
+// Package comparator provides the Comparator type, a three-way comparison
+// function which returns an oxide.Ordering, alongside combinators for building
+// complex orderings out of simple ones.
+//
+// Comparators can express ties, which makes it possible to chain orderings
+// together, such as sorting by one field and then by another. They can be
+// adapted to the less and cmp functions accepted throughout the iter package
+// by way of Less and CmpFunc.
+package comparator
@@ -0,0 +1,43 @@
+package comparator_test
+
+import (
+	"fmt"
+
+	"github.com/moogar0880/oxide/comparator"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func ExampleThenBy() {
+	type employee struct {
+		name string
+		team string
+		age  int
+	}
+
+	{
+		// Define an iterator over our pre-defined data.
+		employees := iter.FromSlice([]employee{
+			{"grace", "infra", 45},
+			{"alan", "data", 41},
+			{"ada", "infra", 36},
+			{"edsger", "data", 41},
+		})
+
+		// Order employees by team, then from oldest to youngest, then by name.
+		order := comparator.ThenBy(
+			comparator.ByKey(func(e employee) string { return e.team }),
+			comparator.Reverse(comparator.ByKey(func(e employee) int { return e.age })),
+			comparator.ByKey(func(e employee) string { return e.name }),
+		)
+
+		sorted := iter.Sorted(employees, comparator.Less(order))
+		iter.ForEach(sorted, func(e *employee) {
+			fmt.Println(e.team, e.age, e.name)
+		})
+	}
+	// Output:
+	// data 41 alan
+	// data 41 edsger
+	// infra 45 grace
+	// infra 36 ada
+}
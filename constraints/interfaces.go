@@ -1,13 +1,49 @@
 package constraints
 
+// A Signed is a generic type which accounts for all the signed whole number
+// types supported by the stdlib.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// An Unsigned is a generic type which accounts for all the unsigned whole
+// number types supported by the stdlib.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
 // An Integer is a generic type which accounts for all the whole number types
 // supported by the stdlib.
 type Integer interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+	Signed | Unsigned
+}
+
+// A Float is a generic type which accounts for all the floating point types
+// supported by the stdlib.
+type Float interface {
+	~float32 | ~float64
+}
+
+// A Complex is a generic type which accounts for all the complex number types
+// supported by the stdlib.
+type Complex interface {
+	~complex64 | ~complex128
 }
 
 // A Number is a generic type which accounts for all the builtin numerical
 // types.
 type Number interface {
-	Integer | ~float32 | ~float64
+	Integer | Float
+}
+
+// An Ordered is a generic type which accounts for all the builtin types which
+// support the <, <=, >= and > operators.
+type Ordered interface {
+	Number | ~string
+}
+
+// An Addable is a generic type which accounts for all the builtin types which
+// support the + operator.
+type Addable interface {
+	Number | Complex | ~string
 }
@@ -0,0 +1,56 @@
+// Package lessheap provides the heap.Interface implementation shared by the
+// heap-backed APIs of the iter and collections modules.
+package lessheap
+
+// Heap enables dynamically maintaining a binary heap of values of type T
+// using a specified implementation of the Less function required to implement
+// heap.Interface.
+type Heap[T any] struct {
+	LessFunc func(a, b T) bool
+	Data     []T
+
+	// Moved, if set, is called whenever an element is placed at a new index
+	// of the heap, which allows callers to track the position of elements.
+	Moved func(value T, index int)
+}
+
+// Len implements heap.Interface and returns the number of elements in the
+// heap.
+func (h *Heap[T]) Len() int {
+	return len(h.Data)
+}
+
+// Less implements heap.Interface and reports whether the element with index i
+// must be popped before the element with index j.
+func (h *Heap[T]) Less(i, j int) bool {
+	return h.LessFunc(h.Data[i], h.Data[j])
+}
+
+// Swap implements heap.Interface and is responsible for swapping the elements
+// with indexes i and j.
+func (h *Heap[T]) Swap(i, j int) {
+	h.Data[i], h.Data[j] = h.Data[j], h.Data[i]
+	if h.Moved != nil {
+		h.Moved(h.Data[i], i)
+		h.Moved(h.Data[j], j)
+	}
+}
+
+// Push implements heap.Interface and appends x to the end of the heap.
+func (h *Heap[T]) Push(x any) {
+	h.Data = append(h.Data, x.(T))
+	if h.Moved != nil {
+		h.Moved(x.(T), len(h.Data)-1)
+	}
+}
+
+// Pop implements heap.Interface and removes the final element of the heap.
+func (h *Heap[T]) Pop() any {
+	var zero T
+
+	last := h.Data[len(h.Data)-1]
+	h.Data[len(h.Data)-1] = zero
+	h.Data = h.Data[:len(h.Data)-1]
+
+	return last
+}
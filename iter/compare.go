@@ -0,0 +1,152 @@
+package iter
+
+import (
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+)
+
+// Eq consumes the provided iterators, returning a boolean value which indicates
+// whether they yield equal elements in the same order. Iteration stops as soon
+// as a pair of unequal elements is found, or either iterator is exhausted.
+func Eq[T comparable](a, b Interface[T]) bool {
+	return EqBy(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqBy consumes the provided iterators, returning a boolean value which
+// indicates whether they yield elements which are equal according to the
+// provided equality function, in the same order. Iteration stops as soon as a
+// pair of unequal elements is found, or either iterator is exhausted.
+func EqBy[A, B any](a Interface[A], b Interface[B], eq func(A, B) bool) bool {
+	return CmpBy(a, b, func(x A, y B) oxide.Ordering {
+		if eq(x, y) {
+			return oxide.Equal
+		}
+
+		return oxide.Less
+	}) == oxide.Equal
+}
+
+// Cmp consumes the provided iterators, lexicographically comparing the
+// elements which they yield.
+//
+// Elements are compared pairwise, and the ordering of the first unequal pair
+// is returned without consuming any further elements. If one iterator is
+// exhausted before the other and all elements up to that point were equal,
+// the shorter iterator is ordered first.
+func Cmp[T constraints.Ordered](a, b Interface[T]) oxide.Ordering {
+	return CmpBy(a, b, oxide.Compare[T])
+}
+
+// CmpBy consumes the provided iterators, lexicographically comparing the
+// elements which they yield using the provided comparison function.
+//
+// For additional details see Cmp.
+func CmpBy[A, B any](a Interface[A], b Interface[B], cmp func(A, B) oxide.Ordering) oxide.Ordering {
+	for {
+		x, okA := a.Next()
+		if !okA {
+			if _, okB := b.Next(); okB {
+				return oxide.Less
+			}
+
+			return oxide.Equal
+		}
+
+		y, okB := b.Next()
+		if !okB {
+			return oxide.Greater
+		}
+
+		if ordering := cmp(x, y); ordering != oxide.Equal {
+			return ordering
+		}
+	}
+}
+
+// PartialCmp consumes the provided iterators, lexicographically comparing the
+// elements which they yield in the same manner as Cmp.
+//
+// Unlike Cmp, a None value is returned if a pair of elements can not be
+// ordered relative to one another, which is the case when either of them is a
+// floating point NaN value.
+func PartialCmp[T constraints.Ordered](a, b Interface[T]) oxide.Option[oxide.Ordering] {
+	ordered := true
+	ordering := CmpBy(a, b, func(x, y T) oxide.Ordering {
+		// NaN is the only value which is not equal to itself.
+		if x != x || y != y {
+			ordered = false
+			return oxide.Less
+		}
+
+		return oxide.Compare(x, y)
+	})
+
+	if !ordered {
+		return oxide.None[oxide.Ordering]()
+	}
+
+	return oxide.Some(ordering)
+}
+
+// Lt consumes the provided iterators, returning a boolean value which
+// indicates whether a is lexicographically less than b.
+//
+// For additional details see PartialCmp.
+func Lt[T constraints.Ordered](a, b Interface[T]) bool {
+	ordering, ok := PartialCmp(a, b).Unpack()
+	return ok && ordering == oxide.Less
+}
+
+// Le consumes the provided iterators, returning a boolean value which
+// indicates whether a is lexicographically less than or equal to b.
+//
+// For additional details see PartialCmp.
+func Le[T constraints.Ordered](a, b Interface[T]) bool {
+	ordering, ok := PartialCmp(a, b).Unpack()
+	return ok && ordering != oxide.Greater
+}
+
+// Gt consumes the provided iterators, returning a boolean value which
+// indicates whether a is lexicographically greater than b.
+//
+// For additional details see PartialCmp.
+func Gt[T constraints.Ordered](a, b Interface[T]) bool {
+	ordering, ok := PartialCmp(a, b).Unpack()
+	return ok && ordering == oxide.Greater
+}
+
+// Ge consumes the provided iterators, returning a boolean value which
+// indicates whether a is lexicographically greater than or equal to b.
+//
+// For additional details see PartialCmp.
+func Ge[T constraints.Ordered](a, b Interface[T]) bool {
+	ordering, ok := PartialCmp(a, b).Unpack()
+	return ok && ordering != oxide.Less
+}
+
+// A Mismatch describes the first position at which two iterators differ. If
+// one iterator was exhausted before the other, its value is None.
+type Mismatch[T any] struct {
+	Index int
+	Left  oxide.Option[T]
+	Right oxide.Option[T]
+}
+
+// Diff consumes the provided iterators until they yield a pair of unequal
+// elements, or one of them is exhausted before the other, returning the
+// position and values of that first mismatch. A None value is returned if the
+// iterators yield equal elements in the same order.
+func Diff[T comparable](a, b Interface[T]) oxide.Option[Mismatch[T]] {
+	for index := 0; ; index++ {
+		left, right := nextOption(a), nextOption(b)
+		if left == right {
+			if left.IsNone() {
+				return oxide.None[Mismatch[T]]()
+			}
+
+			continue
+		}
+
+		return oxide.Some(Mismatch[T]{Index: index, Left: left, Right: right})
+	}
+}
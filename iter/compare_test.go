@@ -0,0 +1,124 @@
+package iter
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+)
+
+func TestCmp(t *testing.T) {
+	testIO := []struct {
+		name   string
+		a, b   []int
+		expect oxide.Ordering
+	}{
+		{name: "should handle empty iterators", a: []int{}, b: []int{}, expect: oxide.Equal},
+		{name: "should handle equal iterators", a: []int{1, 2, 3}, b: []int{1, 2, 3}, expect: oxide.Equal},
+		{name: "should order by the first difference", a: []int{1, 2, 9}, b: []int{1, 3, 0}, expect: oxide.Less},
+		{name: "should order by the first difference", a: []int{2}, b: []int{1, 9}, expect: oxide.Greater},
+		{name: "should order a shorter prefix first", a: []int{1, 2}, b: []int{1, 2, 3}, expect: oxide.Less},
+		{name: "should order a longer iterator last", a: []int{1, 2, 3}, b: []int{1, 2}, expect: oxide.Greater},
+		{name: "should order an empty iterator first", a: []int{}, b: []int{0}, expect: oxide.Less},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Cmp(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, oxide.Some(test.expect), PartialCmp(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, test.expect == oxide.Equal, Eq(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, test.expect == oxide.Less, Lt(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, test.expect != oxide.Greater, Le(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, test.expect == oxide.Greater, Gt(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, test.expect != oxide.Less, Ge(FromSlice(test.a), FromSlice(test.b)))
+		})
+	}
+}
+
+func TestCmp_ShortCircuits(t *testing.T) {
+	// Infinite iterators can be compared as long as they differ.
+	assert.Equal(t, oxide.Greater, Cmp[int](&countingIterator{current: 1}, &countingIterator{}))
+	assert.Equal(t, false, Eq[int](&countingIterator{}, Range(0, 1000)))
+
+	a, b := FromSlice([]int{1, 2, 3, 4}), FromSlice([]int{1, 5, 3, 4})
+	assert.Equal(t, oxide.Less, Cmp(a, b))
+	assert.Equal(t, []int{3, 4}, CollectSlice(a))
+	assert.Equal(t, []int{3, 4}, CollectSlice(b))
+}
+
+func TestPartialCmp(t *testing.T) {
+	nan := math.NaN()
+
+	testIO := []struct {
+		name   string
+		a, b   []float64
+		expect oxide.Option[oxide.Ordering]
+	}{
+		{name: "should order before a NaN", a: []float64{1, nan}, b: []float64{2, nan}, expect: oxide.Some(oxide.Less)},
+		{name: "should not order a NaN", a: []float64{1, nan}, b: []float64{1, 2}, expect: oxide.None[oxide.Ordering]()},
+		{name: "should not order equal NaNs", a: []float64{nan}, b: []float64{nan}, expect: oxide.None[oxide.Ordering]()},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, PartialCmp(FromSlice(test.a), FromSlice(test.b)))
+
+			ordering, ok := test.expect.Unpack()
+			assert.Equal(t, ok && ordering == oxide.Less, Lt(FromSlice(test.a), FromSlice(test.b)))
+			assert.Equal(t, ok && ordering != oxide.Less, Ge(FromSlice(test.a), FromSlice(test.b)))
+		})
+	}
+}
+
+func TestEqBy(t *testing.T) {
+	labels := FromSlice([]string{"b", "d", "g"})
+	assert.Equal(t, true, EqBy(labels, Filter(FromSlice(sortRecords), func(r *sortRecord) bool {
+		return r.key == 1
+	}), func(label string, r sortRecord) bool {
+		return label == r.label
+	}))
+
+	assert.Equal(t, oxide.Greater, CmpBy(FromSlice([]string{"bb"}), FromSlice([]int{1}), func(s string, i int) oxide.Ordering {
+		return oxide.OrderingOf(len(s) - i)
+	}))
+}
+
+func TestDiff(t *testing.T) {
+	testIO := []struct {
+		name   string
+		a, b   []string
+		expect oxide.Option[Mismatch[string]]
+	}{
+		{
+			name:   "should handle equal iterators",
+			a:      []string{"a", "b"},
+			b:      []string{"a", "b"},
+			expect: oxide.None[Mismatch[string]](),
+		},
+		{
+			name:   "should find the first unequal elements",
+			a:      []string{"a", "b", "c"},
+			b:      []string{"a", "x", "y"},
+			expect: oxide.Some(Mismatch[string]{Index: 1, Left: oxide.Some("b"), Right: oxide.Some("x")}),
+		},
+		{
+			name:   "should handle a shorter left iterator",
+			a:      []string{"a"},
+			b:      []string{"a", ""},
+			expect: oxide.Some(Mismatch[string]{Index: 1, Left: oxide.None[string](), Right: oxide.Some("")}),
+		},
+		{
+			name:   "should handle a shorter right iterator",
+			a:      []string{"a"},
+			b:      []string{},
+			expect: oxide.Some(Mismatch[string]{Index: 0, Left: oxide.Some("a"), Right: oxide.None[string]()}),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Diff(FromSlice(test.a), FromSlice(test.b)))
+		})
+	}
+}
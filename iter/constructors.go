@@ -1,6 +1,8 @@
 package iter
 
 import (
+	"math"
+
 	"github.com/moogar0880/oxide"
 	"github.com/moogar0880/oxide/constraints"
 )
@@ -27,6 +29,16 @@ func FromChannel[T any](data <-chan T) Interface[T] {
 	return &chanIterator[T]{data: data}
 }
 
+// FromChannelBlocking returns a new iterator which can be used to traverse
+// through all the values yielded by the provided channel.
+//
+// Unlike FromChannel, calls to Next on the returned iterator block until either
+// a value is received or the channel is closed. A nil channel is treated as
+// an already closed channel rather than blocking forever.
+func FromChannelBlocking[T any](data <-chan T) Interface[T] {
+	return &blockingChanIterator[T]{data: data}
+}
+
 // Range returns a new iterator which can be used to iterate over all values in
 // a range of numbers.
 func Range[T constraints.Integer](from, to T) Interface[T] {
@@ -47,6 +59,20 @@ func (i *sliceIterator[T]) Next() (value T, ok bool) {
 	return value, true
 }
 
+func (i *sliceIterator[T]) NextBack() (value T, ok bool) {
+	if len(i.slice) == 0 {
+		return
+	}
+
+	value = i.slice[len(i.slice)-1]
+	i.slice = i.slice[:len(i.slice)-1]
+	return value, true
+}
+
+func (i *sliceIterator[T]) Len() int {
+	return len(i.slice)
+}
+
 func (i *sliceIterator[T]) SizeHint() (int64, oxide.Option[int64]) {
 	max := cap(i.slice)
 	if max == 0 {
@@ -93,6 +119,20 @@ func (i *chanIterator[T]) SizeHint() (int64, oxide.Option[int64]) {
 	return 0, oxide.Some(int64(max))
 }
 
+type blockingChanIterator[T any] struct {
+	data <-chan T
+}
+
+func (i *blockingChanIterator[T]) Next() (T, bool) {
+	if i.data == nil {
+		var zero T
+		return zero, false
+	}
+
+	value, ok := <-i.data
+	return value, ok
+}
+
 type rangeIterator[T constraints.Integer] struct {
 	current, max T
 }
@@ -105,3 +145,31 @@ func (r *rangeIterator[T]) Next() (T, bool) {
 	r.current++
 	return r.current, true
 }
+
+func (r *rangeIterator[T]) NextBack() (T, bool) {
+	if r.current >= r.max {
+		return 0, false
+	}
+
+	value := r.max
+	r.max--
+	return value, true
+}
+
+// Len returns the number of values which the range has yet to yield, capped at
+// math.MaxInt for ranges which are too wide to be counted by an int.
+func (r *rangeIterator[T]) Len() int {
+	if r.current >= r.max {
+		return 0
+	}
+
+	// The difference of two values of any integer type fits within a uint64,
+	// and converting both values to uint64 first keeps the subtraction exact
+	// even when it would overflow T.
+	remaining := uint64(r.max) - uint64(r.current)
+	if remaining > math.MaxInt {
+		return math.MaxInt
+	}
+
+	return int(remaining)
+}
@@ -63,6 +63,26 @@ func TestFromNilChan(t *testing.T) {
 	assert.Equal(t, int64(0), lower)
 }
 
+func TestFromChannelBlocking(t *testing.T) {
+	channel := make(chan int)
+	go func() {
+		defer close(channel)
+		for i := 0; i < 10; i++ {
+			channel <- i
+		}
+	}()
+
+	slice := CollectSlice(FromChannelBlocking(channel))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, slice)
+}
+
+func TestFromNilChanBlocking(t *testing.T) {
+	var channel chan int
+
+	_, ok := FromChannelBlocking(channel).Next()
+	assert.Equal(t, false, ok)
+}
+
 func TestRange(t *testing.T) {
 	testIO := []struct {
 		name   string
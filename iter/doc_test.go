@@ -1,6 +1,7 @@
 package iter_test
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/moogar0880/oxide/iter"
@@ -249,3 +250,73 @@ func ExampleIntersperse() {
 	}
 	// Output: [0 100 1 100 2 100 3 100 4 100 5]
 }
+
+func ExampleParFold() {
+	{
+		// Define an iterator over our pre-defined data.
+		iterator := iter.Range(0, 100)
+
+		sum, err := iter.ParFold(context.Background(), iterator, 4,
+			func() int { return 0 },
+			func(accum int, value *int) int { return accum + *value },
+			func(a, b int) int { return a + b },
+		)
+		fmt.Println(sum, err)
+	}
+	// Output: 5050 <nil>
+}
+
+func ExampleMergeSorted() {
+	{
+		// Define iterators over our pre-sorted data.
+		i1 := iter.FromSlice([]int{1, 4, 7})
+		i2 := iter.FromSlice([]int{2, 5, 8})
+		i3 := iter.FromSlice([]int{3, 6, 9})
+
+		merged := iter.MergeSorted(func(a, b int) bool { return a < b }, i1, i2, i3)
+		fmt.Println(iter.CollectSlice(merged))
+	}
+	// Output: [1 2 3 4 5 6 7 8 9]
+}
+
+func ExampleTopK() {
+	{
+		// Define an iterator over our pre-defined data.
+		iterator := iter.FromSlice([]int{5, 9, 1, 7, 3, 8, 2, 6, 4, 0})
+
+		top := iter.TopK(iterator, 3, func(a, b int) bool { return a < b })
+		fmt.Println(iter.CollectSlice(top))
+	}
+	// Output: [9 8 7]
+}
+
+func ExampleDiff() {
+	{
+		// Define iterators over our pre-defined data.
+		expected := iter.FromSlice([]string{"alpha", "beta", "gamma"})
+		actual := iter.FromSlice([]string{"alpha", "beta", "delta", "epsilon"})
+
+		if mismatch, ok := iter.Diff(expected, actual).Unpack(); ok {
+			fmt.Println(mismatch.Index, mismatch.Left.Value(), mismatch.Right.Value())
+		}
+	}
+	// Output: 2 gamma delta
+}
+
+func ExampleFoldWhile() {
+	{
+		// Define an iterator over our pre-defined data.
+		iterator := iter.FromSlice([]int{3, 1, 4, 1, 5, 9, 2, 6})
+
+		// Sum the elements until the total would exceed 10.
+		flow := iter.FoldWhile(iterator, 0, func(total int, item *int) iter.ControlFlow[int] {
+			if total+*item > 10 {
+				return iter.Break(total)
+			}
+
+			return iter.Continue(total + *item)
+		})
+		fmt.Println(flow.Value(), flow.IsBreak())
+	}
+	// Output: 9 true
+}
@@ -0,0 +1,327 @@
+package iter
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"sort"
+)
+
+// defaultRunSize is the number of elements which are sorted in memory by
+// ExternalSort before being spilled to disk, if no RunSize is specified.
+const defaultRunSize = 1 << 16
+
+// defaultMaxOpenFiles is the number of temporary files which ExternalSort
+// holds open at once while merging runs, if no MaxOpenFiles is specified.
+const defaultMaxOpenFiles = 64
+
+// An Encoder writes values of type T to an underlying stream.
+type Encoder[T any] interface {
+	Encode(value T) error
+}
+
+// A Decoder reads values of type T, which were previously written by an
+// Encoder, from an underlying stream. Decode must return io.EOF once the
+// stream has been exhausted.
+type Decoder[T any] interface {
+	Decode(value *T) error
+}
+
+// A Codec defines how values of type T are serialized when they are written to
+// and read back from temporary files by ExternalSort.
+type Codec[T any] interface {
+	NewEncoder(w io.Writer) Encoder[T]
+	NewDecoder(r io.Reader) Decoder[T]
+}
+
+// GobCodec is a Codec which serializes values using encoding/gob. It is the
+// default Codec used by ExternalSort.
+type GobCodec[T any] struct{}
+
+// NewEncoder implements Codec and returns a gob based Encoder.
+func (GobCodec[T]) NewEncoder(w io.Writer) Encoder[T] {
+	return &gobEncoder[T]{encoder: gob.NewEncoder(w)}
+}
+
+// NewDecoder implements Codec and returns a gob based Decoder.
+func (GobCodec[T]) NewDecoder(r io.Reader) Decoder[T] {
+	return &gobDecoder[T]{decoder: gob.NewDecoder(r)}
+}
+
+type gobEncoder[T any] struct {
+	encoder *gob.Encoder
+}
+
+func (e *gobEncoder[T]) Encode(value T) error {
+	return e.encoder.Encode(value)
+}
+
+type gobDecoder[T any] struct {
+	decoder *gob.Decoder
+}
+
+func (d *gobDecoder[T]) Decode(value *T) error {
+	return d.decoder.Decode(value)
+}
+
+// ExternalSortOptions configures the behavior of ExternalSort.
+type ExternalSortOptions[T any] struct {
+	// RunSize is the maximum number of elements which will be held in memory
+	// and sorted at once. If RunSize is less than or equal to zero, a default
+	// of 65536 elements is used.
+	RunSize int
+
+	// Dir is the directory in which temporary files are created. If Dir is
+	// empty, the default directory for temporary files is used.
+	Dir string
+
+	// Codec is used to serialize elements to and from temporary files. If
+	// Codec is nil, a GobCodec is used.
+	Codec Codec[T]
+
+	// MaxOpenFiles is the maximum number of temporary files which will be
+	// held open at once. If more runs than this are spilled to disk, they are
+	// merged together in several passes, each of which reads and writes every
+	// element once more. If MaxOpenFiles is less than or equal to zero, a
+	// default of 64 files is used, and values less than 3 are rounded up to 3.
+	MaxOpenFiles int
+}
+
+// ExternalSortIterator is the iterator returned by ExternalSort. In addition
+// to the standard Next() method, it allows callers to check for errors which
+// occurred while reading sorted runs back from disk, and to release any
+// temporary files without exhausting the iterator.
+type ExternalSortIterator[T any] struct {
+	merged Interface[T]
+	runs   []*runIterator[T]
+	err    error
+}
+
+// Next implements Interface and yields the next sorted element.
+//
+// Once the iterator is exhausted, or an error has occurred, all temporary
+// files are removed.
+func (i *ExternalSortIterator[T]) Next() (T, bool) {
+	if i.err == nil {
+		if value, ok := i.merged.Next(); ok && i.err == nil {
+			return value, true
+		}
+	}
+
+	i.Close()
+
+	var zero T
+	return zero, false
+}
+
+// Err returns the first error which was encountered while reading sorted runs
+// back from disk, if any.
+func (i *ExternalSortIterator[T]) Err() error {
+	return i.err
+}
+
+// Close removes all temporary files which are still held by the iterator.
+// Once closed, the iterator will not yield any further values.
+func (i *ExternalSortIterator[T]) Close() error {
+	var err error
+	for _, run := range i.runs {
+		err = errors.Join(err, run.close())
+	}
+
+	i.runs = nil
+	i.merged = FromSlice[T](nil)
+	return err
+}
+
+// spill sorts the provided run and writes it to a new temporary file.
+func (i *ExternalSortIterator[T]) spill(run []T, lessFunc func(a, b T) bool, opts ExternalSortOptions[T]) error {
+	sort.Stable(&iterSort[T]{lessFunc: lessFunc, data: run})
+	return i.write(FromSlice(run), opts)
+}
+
+// write consumes the provided iterator, writing its elements to a new
+// temporary file which is closed once they have all been written.
+func (i *ExternalSortIterator[T]) write(source Interface[T], opts ExternalSortOptions[T]) error {
+	file, err := os.CreateTemp(opts.Dir, "oxide-sort-*")
+	if err != nil {
+		return err
+	}
+	i.runs = append(i.runs, &runIterator[T]{owner: i, path: file.Name()})
+
+	buffer := bufio.NewWriter(file)
+	encoder := opts.Codec.NewEncoder(buffer)
+	for item, ok := source.Next(); ok; item, ok = source.Next() {
+		if err := encoder.Encode(item); err != nil {
+			return errors.Join(err, file.Close())
+		}
+	}
+
+	return errors.Join(buffer.Flush(), file.Close())
+}
+
+// compact merges the spilled runs together, in groups of at most
+// opts.MaxOpenFiles-1 consecutive runs, until no more than opts.MaxOpenFiles
+// runs remain. Merging consecutive runs keeps the sort stable.
+func (i *ExternalSortIterator[T]) compact(lessFunc func(a, b T) bool, opts ExternalSortOptions[T]) error {
+	for len(i.runs) > opts.MaxOpenFiles {
+		pending := i.runs
+		i.runs = nil
+
+		for start := 0; start < len(pending); start += opts.MaxOpenFiles - 1 {
+			group := pending[start:min(start+opts.MaxOpenFiles-1, len(pending))]
+			if len(group) == 1 {
+				i.runs = append(i.runs, group[0])
+				continue
+			}
+
+			sources := make([]Interface[T], 0, len(group))
+			for _, run := range group {
+				if err := run.open(opts.Codec); err != nil {
+					i.runs = append(i.runs, pending[start:]...)
+					return err
+				}
+				sources = append(sources, run)
+			}
+
+			err := i.write(MergeSorted(lessFunc, sources...), opts)
+			for _, run := range group {
+				err = errors.Join(err, run.close())
+			}
+
+			if err = errors.Join(err, i.err); err != nil {
+				i.runs = append(i.runs, pending[start+len(group):]...)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// runIterator yields the elements of a single sorted run which was spilled to
+// a temporary file.
+type runIterator[T any] struct {
+	owner   *ExternalSortIterator[T]
+	path    string
+	file    *os.File
+	decoder Decoder[T]
+	removed bool
+}
+
+// open opens the temporary file backing this run for reading.
+func (i *runIterator[T]) open(codec Codec[T]) error {
+	file, err := os.Open(i.path)
+	if err != nil {
+		return err
+	}
+
+	i.file = file
+	i.decoder = codec.NewDecoder(bufio.NewReader(file))
+	return nil
+}
+
+func (i *runIterator[T]) Next() (value T, ok bool) {
+	if i.file == nil {
+		return value, false
+	}
+
+	if err := i.decoder.Decode(&value); err != nil {
+		if !errors.Is(err, io.EOF) && i.owner.err == nil {
+			i.owner.err = err
+		}
+
+		i.close()
+		return value, false
+	}
+
+	return value, true
+}
+
+// close closes and removes the temporary file backing this run.
+func (i *runIterator[T]) close() error {
+	if i.removed {
+		return nil
+	}
+	i.removed = true
+
+	var err error
+	if i.file != nil {
+		err = i.file.Close()
+		i.file = nil
+	}
+
+	return errors.Join(err, os.Remove(i.path))
+}
+
+// ExternalSort returns an iterator in which all elements are sorted according
+// to the provided sorting function, without requiring every element to be held
+// in memory at once.
+//
+// The provided iterator is consumed in runs of at most opts.RunSize elements.
+// Each run is sorted in memory and spilled to a temporary file using
+// opts.Codec, and the runs are then lazily merged back together as the
+// returned iterator is consumed. If all elements fit within a single run, no
+// temporary files are created. The sort is stable.
+//
+// At most opts.MaxOpenFiles temporary files are held open at once. If more
+// runs than that are spilled, they are first merged together in bounded
+// passes, so the number of open file descriptors does not grow with the size
+// of the input.
+//
+// Temporary files are removed once the returned iterator is exhausted, or when
+// Close is called on it. If an error occurs while spilling runs to disk, any
+// temporary files which were already created are removed and the error is
+// returned.
+func ExternalSort[T any](iter Interface[T], lessFunc func(a, b T) bool, opts ExternalSortOptions[T]) (*ExternalSortIterator[T], error) {
+	if opts.RunSize <= 0 {
+		opts.RunSize = defaultRunSize
+	}
+
+	if opts.Codec == nil {
+		opts.Codec = GobCodec[T]{}
+	}
+
+	// Merging in passes requires at least two input runs and an output run.
+	if opts.MaxOpenFiles <= 0 {
+		opts.MaxOpenFiles = defaultMaxOpenFiles
+	} else if opts.MaxOpenFiles < 3 {
+		opts.MaxOpenFiles = 3
+	}
+
+	sorter := &ExternalSortIterator[T]{}
+
+	run := make([]T, 0)
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		run = append(run, item)
+		if len(run) < opts.RunSize {
+			continue
+		}
+
+		if err := sorter.spill(run, lessFunc, opts); err != nil {
+			return nil, errors.Join(err, sorter.Close())
+		}
+		run = run[:0]
+	}
+
+	if err := sorter.compact(lessFunc, opts); err != nil {
+		return nil, errors.Join(err, sorter.Close())
+	}
+
+	// The final run is never spilled to disk, and is instead merged directly
+	// from memory.
+	sort.Stable(&iterSort[T]{lessFunc: lessFunc, data: run})
+
+	sources := make([]Interface[T], 0, len(sorter.runs)+1)
+	for _, spilled := range sorter.runs {
+		if err := spilled.open(opts.Codec); err != nil {
+			return nil, errors.Join(err, sorter.Close())
+		}
+		sources = append(sources, spilled)
+	}
+	sources = append(sources, FromSlice(run))
+
+	sorter.merged = MergeSorted(lessFunc, sources...)
+	return sorter, nil
+}
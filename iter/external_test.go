@@ -0,0 +1,274 @@
+package iter
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+)
+
+func tempFileCount(t *testing.T, dir string) int {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return len(entries)
+}
+
+func TestExternalSort(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = random.Intn(500)
+	}
+
+	expect := make([]int, len(data))
+	copy(expect, data)
+	sort.Ints(expect)
+
+	testIO := []struct {
+		name        string
+		data        []int
+		runSize     int
+		expect      []int
+		expectFiles int
+	}{
+		{
+			name:        "should sort an empty iterator",
+			data:        []int{},
+			runSize:     10,
+			expect:      []int{},
+			expectFiles: 0,
+		},
+		{
+			name:        "should sort within a single run",
+			data:        []int{5, 3, 1, 4, 2},
+			runSize:     10,
+			expect:      []int{1, 2, 3, 4, 5},
+			expectFiles: 0,
+		},
+		{
+			name:        "should sort across many runs",
+			data:        data,
+			runSize:     64,
+			expect:      expect,
+			expectFiles: 15,
+		},
+		{
+			name:        "should sort across runs of a single element",
+			data:        []int{5, 3, 1, 4, 2},
+			runSize:     1,
+			expect:      []int{1, 2, 3, 4, 5},
+			expectFiles: 5,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			sorted, err := ExternalSort(FromSlice(test.data), func(a, b int) bool { return a < b }, ExternalSortOptions[int]{
+				RunSize: test.runSize,
+				Dir:     dir,
+			})
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expectFiles, tempFileCount(t, dir))
+
+			actual := CollectSlice(sorted)
+			assert.Equal(t, test.expect, actual)
+			assert.Equal(t, nil, sorted.Err())
+
+			// All temporary files should be removed once the iterator is
+			// exhausted.
+			assert.Equal(t, 0, tempFileCount(t, dir))
+		})
+	}
+}
+
+func TestExternalSort_Stable(t *testing.T) {
+	type record struct {
+		Key   int
+		Index int
+	}
+
+	data := make([]record, 100)
+	for i := range data {
+		data[i] = record{Key: i % 3, Index: i}
+	}
+
+	// Limiting the number of open files forces the runs to be merged over
+	// several passes, which must also preserve the order of equal elements.
+	for _, maxOpenFiles := range []int{0, 3} {
+		sorted, err := ExternalSort(FromSlice(data), func(a, b record) bool { return a.Key < b.Key }, ExternalSortOptions[record]{
+			RunSize:      7,
+			Dir:          t.TempDir(),
+			MaxOpenFiles: maxOpenFiles,
+		})
+		assert.Equal(t, nil, err)
+
+		actual := CollectSlice(sorted)
+		assert.Equal(t, len(data), len(actual))
+		assert.Equal(t, true, sort.SliceIsSorted(actual, func(i, j int) bool {
+			if actual[i].Key != actual[j].Key {
+				return actual[i].Key < actual[j].Key
+			}
+
+			return actual[i].Index < actual[j].Index
+		}))
+	}
+}
+
+func TestExternalSort_MaxOpenFiles(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	data := random.Perm(5000)
+
+	for _, maxOpenFiles := range []int{1, 3, 4, 10} {
+		dir := t.TempDir()
+
+		sorted, err := ExternalSort(FromSlice(data), func(a, b int) bool { return a < b }, ExternalSortOptions[int]{
+			RunSize:      10,
+			Dir:          dir,
+			MaxOpenFiles: maxOpenFiles,
+		})
+		assert.Equal(t, nil, err)
+
+		// The 500 spilled runs are merged down until they can all be held
+		// open at once, and the intermediate runs are removed along the way.
+		assert.Equal(t, true, tempFileCount(t, dir) <= max(maxOpenFiles, 3))
+
+		assert.Equal(t, CollectSlice(Range(-1, 4999)), CollectSlice(sorted))
+		assert.Equal(t, nil, sorted.Err())
+		assert.Equal(t, 0, tempFileCount(t, dir))
+	}
+}
+
+func TestExternalSort_CompactDecodeError(t *testing.T) {
+	dir := t.TempDir()
+
+	// The runs are merged before ExternalSort returns, so decode errors are
+	// reported by ExternalSort itself.
+	_, err := ExternalSort(FromSlice([]uint32{8, 7, 6, 5, 4, 3, 2, 1}), func(a, b uint32) bool { return a < b }, ExternalSortOptions[uint32]{
+		RunSize:      2,
+		Dir:          dir,
+		Codec:        truncatingCodec{},
+		MaxOpenFiles: 3,
+	})
+	assert.Equal(t, true, errors.Is(err, errTruncated))
+	assert.Equal(t, 0, tempFileCount(t, dir))
+}
+
+func TestExternalSort_Close(t *testing.T) {
+	dir := t.TempDir()
+
+	sorted, err := ExternalSort(Range(0, 100), func(a, b int) bool { return a > b }, ExternalSortOptions[int]{
+		RunSize: 10,
+		Dir:     dir,
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 10, tempFileCount(t, dir))
+
+	value, ok := sorted.Next()
+	assert.Equal(t, 100, value)
+	assert.Equal(t, true, ok)
+
+	assert.Equal(t, nil, sorted.Close())
+	assert.Equal(t, 0, tempFileCount(t, dir))
+
+	_, ok = sorted.Next()
+	assert.Equal(t, false, ok)
+}
+
+// uint32Codec is a fixed-width binary Codec used to exercise custom codecs.
+type uint32Codec struct{}
+
+func (uint32Codec) NewEncoder(w io.Writer) Encoder[uint32] {
+	return &uint32Encoder{w: w}
+}
+
+func (uint32Codec) NewDecoder(r io.Reader) Decoder[uint32] {
+	return &uint32Decoder{r: r}
+}
+
+type uint32Encoder struct {
+	w io.Writer
+}
+
+func (e *uint32Encoder) Encode(value uint32) error {
+	return binary.Write(e.w, binary.LittleEndian, value)
+}
+
+type uint32Decoder struct {
+	r io.Reader
+}
+
+func (d *uint32Decoder) Decode(value *uint32) error {
+	return binary.Read(d.r, binary.LittleEndian, value)
+}
+
+func TestExternalSort_Codec(t *testing.T) {
+	sorted, err := ExternalSort(FromSlice([]uint32{9, 8, 7, 6, 5, 4, 3, 2, 1}), func(a, b uint32) bool { return a < b }, ExternalSortOptions[uint32]{
+		RunSize: 2,
+		Dir:     t.TempDir(),
+		Codec:   uint32Codec{},
+	})
+	assert.Equal(t, nil, err)
+
+	actual := CollectSlice(sorted)
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+	assert.Equal(t, nil, sorted.Err())
+}
+
+// truncatingCodec decodes a single value from each run before failing.
+type truncatingCodec struct {
+	uint32Codec
+}
+
+func (truncatingCodec) NewDecoder(r io.Reader) Decoder[uint32] {
+	return &truncatingDecoder{inner: &uint32Decoder{r: r}}
+}
+
+type truncatingDecoder struct {
+	inner   Decoder[uint32]
+	decoded bool
+}
+
+var errTruncated = errors.New("truncated")
+
+func (d *truncatingDecoder) Decode(value *uint32) error {
+	if d.decoded {
+		return errTruncated
+	}
+
+	d.decoded = true
+	return d.inner.Decode(value)
+}
+
+func TestExternalSort_DecodeError(t *testing.T) {
+	dir := t.TempDir()
+
+	sorted, err := ExternalSort(FromSlice([]uint32{4, 3, 2, 1}), func(a, b uint32) bool { return a < b }, ExternalSortOptions[uint32]{
+		RunSize: 2,
+		Dir:     dir,
+		Codec:   truncatingCodec{},
+	})
+	assert.Equal(t, nil, err)
+
+	CollectSlice(sorted)
+	assert.Equal(t, errTruncated, sorted.Err())
+	assert.Equal(t, 0, tempFileCount(t, dir))
+}
+
+func TestExternalSort_SpillError(t *testing.T) {
+	_, err := ExternalSort(Range(0, 10), func(a, b int) bool { return a < b }, ExternalSortOptions[int]{
+		RunSize: 2,
+		Dir:     "/this/directory/does/not/exist",
+	})
+
+	assert.Equal(t, true, err != nil)
+}
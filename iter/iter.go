@@ -1,6 +1,7 @@
 package iter
 
 import (
+	"errors"
 	"sort"
 
 	"github.com/moogar0880/oxide"
@@ -271,6 +272,10 @@ func (i *chainIterator[T]) Next() (T, bool) {
 // Zip returns an iterator which "zips" up the two provided iterators. This
 // iterator will return an array of size 2 which contains the next items yielded
 // from both iterators.
+//
+// Note: once the shorter iterator is exhausted, its side of each pair is
+// padded with the zero value of T until the longer iterator is exhausted. Use
+// ZipExact in order to detect iterators of different lengths instead.
 func Zip[T any](left Interface[T], right Interface[T]) Interface[[2]T] {
 	return &zipIterator[T]{left: left, right: right}
 }
@@ -298,6 +303,56 @@ func (i *zipIterator[T]) Next() ([2]T, bool) {
 	return [2]T{zero, zero}, false
 }
 
+// ErrLengthMismatch is returned when two iterators which are consumed in
+// lockstep yield a different number of values.
+var ErrLengthMismatch = errors.New("iter: iterators have different lengths")
+
+// ZipExact returns an iterator which yields an array of size 2 containing the
+// next items yielded from both of the provided iterators. Unlike Zip, the
+// returned iterator is exhausted as soon as either iterator is, and its Err
+// method reports ErrLengthMismatch if the other iterator had not also been
+// exhausted.
+func ZipExact[T any](left Interface[T], right Interface[T]) *ZipExactIterator[T] {
+	return &ZipExactIterator[T]{left: left, right: right}
+}
+
+// ZipExactIterator is the iterator returned by ZipExact. In addition to the
+// standard Next() method, it allows callers to check whether the zipped
+// iterators were of different lengths.
+type ZipExactIterator[T any] struct {
+	left  Interface[T]
+	right Interface[T]
+	err   error
+	done  bool
+}
+
+// Next implements Interface and yields the next pair of values.
+func (i *ZipExactIterator[T]) Next() ([2]T, bool) {
+	if i.done {
+		return [2]T{}, false
+	}
+
+	v1, ok1 := i.left.Next()
+	v2, ok2 := i.right.Next()
+	if !ok1 || !ok2 {
+		if ok1 != ok2 {
+			i.err = ErrLengthMismatch
+		}
+
+		i.done = true
+		return [2]T{}, false
+	}
+
+	return [2]T{v1, v2}, true
+}
+
+// Err returns ErrLengthMismatch if the iterator was exhausted because one of
+// the zipped iterators yielded fewer values than the other. Err should only be
+// checked once the iterator has been exhausted.
+func (i *ZipExactIterator[T]) Err() error {
+	return i.err
+}
+
 // All consumes the provided iterator, returning a boolean value which
 // indicates whether all the values yielded by the iterator satisfied the
 // provided predicate.
@@ -472,6 +527,44 @@ func Interleave[T any](iter1, iter2 Interface[T]) Interface[T] {
 	}
 }
 
+type revIterator[T any] struct {
+	inner DoubleEnded[T]
+}
+
+func (i *revIterator[T]) Next() (T, bool) {
+	return i.inner.NextBack()
+}
+
+func (i *revIterator[T]) NextBack() (T, bool) {
+	return i.inner.Next()
+}
+
+func (i *revIterator[T]) SizeHint() (int64, oxide.Option[int64]) {
+	return SizeHint[T](i.inner)
+}
+
+// exactRevIterator is a revIterator over an iterator which also implements
+// ExactSizer, so that the reversed iterator implements it too.
+type exactRevIterator[T any] struct {
+	revIterator[T]
+	sizer ExactSizer
+}
+
+func (i *exactRevIterator[T]) Len() int {
+	return i.sizer.Len()
+}
+
+// Rev returns an iterator which yields the elements of the provided
+// double-ended iterator in reverse order, from the back to the front. The
+// returned iterator also implements ExactSizer if the provided iterator does.
+func Rev[T any](iter DoubleEnded[T]) DoubleEnded[T] {
+	if sizer, ok := iter.(ExactSizer); ok {
+		return &exactRevIterator[T]{revIterator: revIterator[T]{inner: iter}, sizer: sizer}
+	}
+
+	return &revIterator[T]{inner: iter}
+}
+
 // Sorted returns an Interface in which all elements are sorted according to
 // the provided sorting function.
 func Sorted[T any](iter Interface[T], lessFunc func(a, b T) bool) Interface[T] {
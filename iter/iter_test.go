@@ -615,6 +615,30 @@ func TestZip(t *testing.T) {
 	}
 }
 
+func TestZipExact(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data1  []int
+		data2  []int
+		expect [][2]int
+		err    error
+	}{
+		{name: "should zip two collections of equal length", data1: []int{1, 2}, data2: []int{3, 4}, expect: [][2]int{{1, 3}, {2, 4}}},
+		{name: "should zip two empty collections", data1: []int{}, data2: []int{}, expect: [][2]int{}},
+		{name: "should report a shorter left collection", data1: []int{1}, data2: []int{3, 4}, expect: [][2]int{{1, 3}}, err: ErrLengthMismatch},
+		{name: "should report a shorter right collection", data1: []int{1, 2}, data2: []int{3}, expect: [][2]int{{1, 3}}, err: ErrLengthMismatch},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			zipped := ZipExact(FromSlice(test.data1), FromSlice(test.data2))
+
+			assert.Equal(t, test.expect, CollectSlice[[2]int](zipped))
+			assert.Equal(t, test.err, zipped.Err())
+		})
+	}
+}
+
 func TestAll(t *testing.T) {
 	testIO := []struct {
 		name   string
@@ -888,3 +912,31 @@ func TestSort(t *testing.T) {
 type unboundedIterator struct{}
 
 func (*unboundedIterator) Next() (data int, present bool) { return }
+
+func TestRev(t *testing.T) {
+	rev := Rev(FromSlice([]int{1, 2, 3, 4}).(DoubleEnded[int]))
+
+	lower, upper := SizeHint[int](rev)
+	assert.Equal(t, int64(0), lower)
+	assert.Equal(t, oxide.Some(int64(4)), upper)
+
+	last, _ := rev.NextBack()
+	assert.Equal(t, 1, last)
+	assert.Equal(t, []int{4, 3, 2}, CollectSlice[int](rev))
+	assert.Equal(t, []int{1, 2, 3}, CollectSlice[int](Rev(Rev(Range(0, 3).(DoubleEnded[int])))))
+}
+
+func TestRev_ExactSizer(t *testing.T) {
+	rev := Rev(Rev(Range(0, 5).(DoubleEnded[int])))
+	sizer, ok := rev.(ExactSizer)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 5, sizer.Len())
+
+	rev.Next()
+	rev.NextBack()
+	assert.Equal(t, 3, sizer.Len())
+
+	// Iterators which are not sized do not gain a Len method.
+	_, ok = Rev[int](doubleEndedOnly[int]{FromSlice([]int{1}).(DoubleEnded[int])}).(ExactSizer)
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,172 @@
+package iter
+
+import (
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+)
+
+// A JoinKind determines which values are yielded by MergeJoin for keys which
+// are only present in one of the two joined iterators.
+type JoinKind int
+
+const (
+	// InnerJoin only yields pairs of values whose keys are present in both
+	// iterators.
+	InnerJoin JoinKind = iota
+
+	// LeftJoin yields pairs of values whose keys are present in both
+	// iterators, as well as values from the left iterator whose keys are not
+	// present in the right iterator.
+	LeftJoin
+
+	// OuterJoin yields pairs of values whose keys are present in both
+	// iterators, as well as values from either iterator whose keys are not
+	// present in the other iterator.
+	OuterJoin
+)
+
+// Joined is a pair of values which are yielded by MergeJoin. A None value on
+// either side indicates that no value with a matching key was present in the
+// corresponding iterator.
+type Joined[L, R any] struct {
+	Left  oxide.Option[L]
+	Right oxide.Option[R]
+}
+
+// MergeJoin returns an iterator which pairs up values with equal keys from the
+// provided iterators, in the style of a sort-merge-join. Both iterators must
+// already be sorted in ascending order of the keys returned by their
+// respective key functions.
+//
+// When a key is present multiple times in both iterators, a pair is yielded
+// for every combination of the matching values. The provided JoinKind
+// determines whether values whose keys are only present in one of the
+// iterators are also yielded.
+//
+// Floating point NaN keys are not equal to any key, including other NaN keys,
+// so values with NaN keys are never paired up and are treated as if their keys
+// were only present in their own iterator.
+func MergeJoin[L, R any, K constraints.Ordered](left Interface[L], right Interface[R], leftKey func(L) K, rightKey func(R) K, kind JoinKind) Interface[Joined[L, R]] {
+	return &mergeJoinIterator[L, R, K]{
+		left:     left,
+		right:    right,
+		leftKey:  leftKey,
+		rightKey: rightKey,
+		kind:     kind,
+	}
+}
+
+type mergeJoinIterator[L, R any, K constraints.Ordered] struct {
+	left     Interface[L]
+	right    Interface[R]
+	leftKey  func(L) K
+	rightKey func(R) K
+	kind     JoinKind
+
+	init      bool
+	leftHead  oxide.Option[L]
+	rightHead oxide.Option[R]
+	pending   []Joined[L, R]
+}
+
+func (i *mergeJoinIterator[L, R, K]) Next() (Joined[L, R], bool) {
+	if !i.init {
+		i.init = true
+		i.leftHead = nextOption(i.left)
+		i.rightHead = nextOption(i.right)
+	}
+
+	for len(i.pending) == 0 {
+		leftOk, rightOk := i.leftHead.IsSome(), i.rightHead.IsSome()
+
+		switch {
+		case !leftOk && !rightOk:
+			var zero Joined[L, R]
+			return zero, false
+		case !rightOk || (leftOk && i.leftKey(i.leftHead.Value()) < i.rightKey(i.rightHead.Value())):
+			if i.kind == InnerJoin && !rightOk {
+				i.leftHead = oxide.None[L]()
+				continue
+			}
+
+			i.skipLeft()
+		case !leftOk || i.rightKey(i.rightHead.Value()) < i.leftKey(i.leftHead.Value()):
+			if i.kind != OuterJoin && !leftOk {
+				i.rightHead = oxide.None[R]()
+				continue
+			}
+
+			i.skipRight()
+		default:
+			// Neither key is less than the other, so either the keys are equal
+			// or at least one of them is NaN, which matches nothing.
+			if key := i.leftKey(i.leftHead.Value()); key != key {
+				i.skipLeft()
+			} else if key := i.rightKey(i.rightHead.Value()); key != key {
+				i.skipRight()
+			} else {
+				i.joinGroups()
+			}
+		}
+	}
+
+	joined := i.pending[0]
+	i.pending = i.pending[1:]
+
+	return joined, true
+}
+
+// skipLeft advances past the current left head, which has no matching value in
+// the right iterator.
+func (i *mergeJoinIterator[L, R, K]) skipLeft() {
+	if i.kind != InnerJoin {
+		i.pending = append(i.pending, Joined[L, R]{Left: i.leftHead, Right: oxide.None[R]()})
+	}
+	i.leftHead = nextOption(i.left)
+}
+
+// skipRight advances past the current right head, which has no matching value
+// in the left iterator.
+func (i *mergeJoinIterator[L, R, K]) skipRight() {
+	if i.kind == OuterJoin {
+		i.pending = append(i.pending, Joined[L, R]{Left: oxide.None[L](), Right: i.rightHead})
+	}
+	i.rightHead = nextOption(i.right)
+}
+
+// joinGroups consumes every value from both iterators which share the key of
+// the current heads, and queues up the cross product of those values.
+func (i *mergeJoinIterator[L, R, K]) joinGroups() {
+	key := i.leftKey(i.leftHead.Value())
+
+	lefts := make([]L, 0, 1)
+	for i.leftHead.IsSome() && i.leftKey(i.leftHead.Value()) == key {
+		lefts = append(lefts, i.leftHead.Value())
+		i.leftHead = nextOption(i.left)
+	}
+
+	rights := make([]R, 0, 1)
+	for i.rightHead.IsSome() && i.rightKey(i.rightHead.Value()) == key {
+		rights = append(rights, i.rightHead.Value())
+		i.rightHead = nextOption(i.right)
+	}
+
+	for _, left := range lefts {
+		for _, right := range rights {
+			i.pending = append(i.pending, Joined[L, R]{
+				Left:  oxide.Some(left),
+				Right: oxide.Some(right),
+			})
+		}
+	}
+}
+
+// nextOption advances the provided iterator, returning the yielded value as
+// an oxide.Option.
+func nextOption[T any](iter Interface[T]) oxide.Option[T] {
+	if value, ok := iter.Next(); ok {
+		return oxide.Some(value)
+	}
+
+	return oxide.None[T]()
+}
@@ -0,0 +1,156 @@
+package iter
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+)
+
+func TestMergeJoin(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	type order struct {
+		userID int
+		item   string
+	}
+
+	some := oxide.Some[user]
+	someOrder := oxide.Some[order]
+	noUser := oxide.None[user]()
+	noOrder := oxide.None[order]()
+
+	users := []user{{1, "alice"}, {2, "bob"}, {4, "dan"}}
+	orders := []order{{1, "book"}, {1, "pen"}, {3, "lamp"}, {4, "mug"}, {5, "cup"}}
+
+	testIO := []struct {
+		name   string
+		left   []user
+		right  []order
+		kind   JoinKind
+		expect []Joined[user, order]
+	}{
+		{
+			name:  "should inner join",
+			left:  users,
+			right: orders,
+			kind:  InnerJoin,
+			expect: []Joined[user, order]{
+				{some(user{1, "alice"}), someOrder(order{1, "book"})},
+				{some(user{1, "alice"}), someOrder(order{1, "pen"})},
+				{some(user{4, "dan"}), someOrder(order{4, "mug"})},
+			},
+		},
+		{
+			name:  "should left join",
+			left:  users,
+			right: orders,
+			kind:  LeftJoin,
+			expect: []Joined[user, order]{
+				{some(user{1, "alice"}), someOrder(order{1, "book"})},
+				{some(user{1, "alice"}), someOrder(order{1, "pen"})},
+				{some(user{2, "bob"}), noOrder},
+				{some(user{4, "dan"}), someOrder(order{4, "mug"})},
+			},
+		},
+		{
+			name:  "should outer join",
+			left:  users,
+			right: orders,
+			kind:  OuterJoin,
+			expect: []Joined[user, order]{
+				{some(user{1, "alice"}), someOrder(order{1, "book"})},
+				{some(user{1, "alice"}), someOrder(order{1, "pen"})},
+				{some(user{2, "bob"}), noOrder},
+				{noUser, someOrder(order{3, "lamp"})},
+				{some(user{4, "dan"}), someOrder(order{4, "mug"})},
+				{noUser, someOrder(order{5, "cup"})},
+			},
+		},
+		{
+			name:  "should join many to many",
+			left:  []user{{1, "a"}, {1, "b"}},
+			right: []order{{1, "x"}, {1, "y"}},
+			kind:  InnerJoin,
+			expect: []Joined[user, order]{
+				{some(user{1, "a"}), someOrder(order{1, "x"})},
+				{some(user{1, "a"}), someOrder(order{1, "y"})},
+				{some(user{1, "b"}), someOrder(order{1, "x"})},
+				{some(user{1, "b"}), someOrder(order{1, "y"})},
+			},
+		},
+		{
+			name:   "should inner join with an empty left iterator",
+			left:   []user{},
+			right:  orders,
+			kind:   InnerJoin,
+			expect: []Joined[user, order]{},
+		},
+		{
+			name:  "should left join with an empty right iterator",
+			left:  users[:2],
+			right: []order{},
+			kind:  LeftJoin,
+			expect: []Joined[user, order]{
+				{some(user{1, "alice"}), noOrder},
+				{some(user{2, "bob"}), noOrder},
+			},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := CollectSlice(MergeJoin(
+				FromSlice(test.left),
+				FromSlice(test.right),
+				func(u user) int { return u.id },
+				func(o order) int { return o.userID },
+				test.kind,
+			))
+
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestMergeJoin_NaNKeys(t *testing.T) {
+	nan := math.NaN()
+	testIO := []struct {
+		name   string
+		kind   JoinKind
+		expect []string
+	}{
+		{name: "should not pair NaN keys in an inner join", kind: InnerJoin, expect: []string{"1/1"}},
+		{name: "should yield NaN keys as unmatched in a left join", kind: LeftJoin, expect: []string{"NaN/-", "1/1"}},
+		{name: "should yield NaN keys as unmatched in an outer join", kind: OuterJoin, expect: []string{"NaN/-", "-/NaN", "1/1"}},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			joined := MergeJoin(
+				FromSlice([]float64{nan, 1}),
+				FromSlice([]float64{nan, 1}),
+				func(f float64) float64 { return f },
+				func(f float64) float64 { return f },
+				test.kind,
+			)
+
+			actual := CollectSlice(Map(joined, func(j Joined[float64, float64]) string {
+				return formatOption(j.Left) + "/" + formatOption(j.Right)
+			}))
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func formatOption(option oxide.Option[float64]) string {
+	if value, ok := option.Unpack(); ok {
+		return fmt.Sprint(value)
+	}
+
+	return "-"
+}
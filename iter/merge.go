@@ -0,0 +1,165 @@
+package iter
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/internal/lessheap"
+)
+
+// MergeChannels fans in all the provided channels onto a single channel,
+// yielding values from whichever source channel is ready first.
+//
+// Nil channels are treated as sources which have already been exhausted. The
+// returned channel is closed once every source channel has been closed, or
+// once the provided context is done, whichever happens first.
+func MergeChannels[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	for _, ch := range chans {
+		if ch == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(ch <-chan T) {
+			defer wg.Done()
+
+			for {
+				select {
+				case value, ok := <-ch:
+					if !ok {
+						return
+					}
+
+					select {
+					case out <- value:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Merge returns an iterator which yields the values from all the provided
+// iterators, in whichever order they become available.
+//
+// Each iterator is consumed from its own goroutine, so the provided iterators
+// must not be consumed elsewhere while the returned iterator is in use. Nil
+// iterators are ignored. Calls to Next on the returned iterator block until a
+// value is available, and the returned iterator is exhausted once all the
+// provided iterators are exhausted or once the provided context is done.
+//
+// Note: the goroutines consuming the provided iterators will only exit once
+// the returned iterator has been fully consumed or the context is done, so
+// callers that stop consuming early should cancel the context.
+func Merge[T any](ctx context.Context, iters ...Interface[T]) Interface[T] {
+	chans := make([]<-chan T, 0, len(iters))
+	for _, iter := range iters {
+		if iter == nil {
+			continue
+		}
+
+		chans = append(chans, CollectChan(ctx, iter, 0))
+	}
+
+	return FromChannelBlocking(MergeChannels(ctx, chans...))
+}
+
+// mergeHead tracks the most recently yielded value from one of the iterators
+// being merged by MergeSorted.
+type mergeHead[T any] struct {
+	value  T
+	source int
+}
+
+type mergeSortedIterator[T any] struct {
+	iters []Interface[T]
+	heap  *lessheap.Heap[mergeHead[T]]
+	init  bool
+
+	// yielded tracks whether the head of the heap was yielded by the previous
+	// call to Next, and must be replaced by the next value from its source.
+	yielded bool
+}
+
+func (i *mergeSortedIterator[T]) Next() (T, bool) {
+	if !i.init {
+		i.init = true
+		for source, iter := range i.iters {
+			if value, ok := iter.Next(); ok {
+				i.heap.Data = append(i.heap.Data, mergeHead[T]{value: value, source: source})
+			}
+		}
+		heap.Init(i.heap)
+	}
+
+	if i.yielded {
+		i.yielded = false
+
+		source := i.heap.Data[0].source
+		if value, ok := i.iters[source].Next(); ok {
+			i.heap.Data[0] = mergeHead[T]{value: value, source: source}
+			heap.Fix(i.heap, 0)
+		} else {
+			heap.Pop(i.heap)
+		}
+	}
+
+	if i.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	i.yielded = true
+	return i.heap.Data[0].value, true
+}
+
+// MergeSorted returns an iterator which lazily merges the provided iterators,
+// each of which must already be sorted according to the provided sorting
+// function, into a single sorted sequence.
+//
+// Only the next value of each iterator is held in memory at any given time.
+// The merge is stable: when values from different iterators are equal, values
+// from iterators which were provided earlier are yielded first.
+func MergeSorted[T any](lessFunc func(a, b T) bool, iters ...Interface[T]) Interface[T] {
+	return &mergeSortedIterator[T]{
+		iters: iters,
+		heap: &lessheap.Heap[mergeHead[T]]{
+			LessFunc: func(a, b mergeHead[T]) bool {
+				if lessFunc(a.value, b.value) {
+					return true
+				} else if lessFunc(b.value, a.value) {
+					return false
+				}
+
+				return a.source < b.source
+			},
+			Data: make([]mergeHead[T], 0, len(iters)),
+		},
+	}
+}
+
+// MergeSortedBy returns an iterator which lazily merges the provided
+// iterators, each of which must already be sorted in ascending order of the
+// key returned by the provided function, into a single sorted sequence.
+//
+// For additional details see MergeSorted.
+func MergeSortedBy[T any, K constraints.Ordered](key func(T) K, iters ...Interface[T]) Interface[T] {
+	return MergeSorted(func(a, b T) bool {
+		return key(a) < key(b)
+	}, iters...)
+}
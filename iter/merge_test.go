@@ -0,0 +1,231 @@
+package iter
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/moogar0880/oxide/assert"
+)
+
+func sendAll[T any](values ...T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, value := range values {
+			out <- value
+		}
+	}()
+	return out
+}
+
+func TestMergeChannels(t *testing.T) {
+	testIO := []struct {
+		name   string
+		chans  []<-chan int
+		expect []int
+	}{
+		{
+			name:   "should merge no channels",
+			chans:  nil,
+			expect: []int{},
+		},
+		{
+			name:   "should merge a single channel",
+			chans:  []<-chan int{sendAll(1, 2, 3)},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name:   "should merge many channels",
+			chans:  []<-chan int{sendAll(1, 4), sendAll(2, 5, 6), sendAll(3)},
+			expect: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:   "should ignore nil channels",
+			chans:  []<-chan int{nil, sendAll(1, 2), nil},
+			expect: []int{1, 2},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			actual := make([]int, 0)
+			for value := range MergeChannels(ctx, test.chans...) {
+				actual = append(actual, value)
+			}
+			sort.Ints(actual)
+
+			assert.Equal(t, test.expect, actual)
+			assert.Equal(t, nil, ctx.Err())
+		})
+	}
+}
+
+func TestMergeChannels_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// A channel which is never written to or closed would block forever if
+	// cancellation were not respected.
+	merged := MergeChannels(ctx, make(chan int), sendAll(1))
+	assert.Equal(t, 1, <-merged)
+	cancel()
+
+	_, ok := <-merged
+	assert.Equal(t, false, ok)
+}
+
+func TestMerge(t *testing.T) {
+	testIO := []struct {
+		name   string
+		iters  []Interface[int]
+		expect []int
+	}{
+		{
+			name:   "should merge no iterators",
+			iters:  nil,
+			expect: []int{},
+		},
+		{
+			name: "should merge many iterators",
+			iters: []Interface[int]{
+				FromSlice([]int{1, 4, 7}),
+				FromSlice([]int{2, 5}),
+				FromSlice([]int{3, 6, 8, 9}),
+			},
+			expect: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name:   "should ignore nil iterators",
+			iters:  []Interface[int]{nil, FromSlice([]int{1, 2})},
+			expect: []int{1, 2},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			actual := CollectSlice(Merge(ctx, test.iters...))
+			sort.Ints(actual)
+
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestMerge_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	merged := Merge(ctx, Interface[int](&countingIterator{}))
+
+	// The unbounded source iterator can only be exhausted through the
+	// cancellation of the context, and at most a handful of values may race
+	// past the cancellation before it is observed.
+	assert.Equal(t, true, Count(merged) < 100)
+}
+
+func TestMerge_CancelledMidStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	merged := Merge(ctx, Interface[int](&countingIterator{}))
+	assert.Equal(t, 10, len(CollectSlice(Take(merged, 10))))
+
+	cancel()
+	remaining := make(chan int, 1)
+	go func() {
+		remaining <- Count(merged)
+	}()
+
+	select {
+	case count := <-remaining:
+		assert.Equal(t, true, count < 100)
+	case <-time.After(time.Second):
+		t.Fatal("merged iterator was not exhausted after the context was cancelled")
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	testIO := []struct {
+		name   string
+		iters  []Interface[int]
+		expect []int
+	}{
+		{
+			name:   "should merge no iterators",
+			iters:  nil,
+			expect: []int{},
+		},
+		{
+			name:   "should merge a single iterator",
+			iters:  []Interface[int]{FromSlice([]int{1, 2, 3})},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name: "should merge many iterators",
+			iters: []Interface[int]{
+				FromSlice([]int{1, 4, 7, 10}),
+				FromSlice([]int{}),
+				FromSlice([]int{2, 5, 8}),
+				FromSlice([]int{0, 3, 6, 9, 11, 12}),
+			},
+			expect: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+		},
+		{
+			name: "should merge duplicate values",
+			iters: []Interface[int]{
+				FromSlice([]int{1, 1, 3}),
+				FromSlice([]int{1, 2, 3}),
+			},
+			expect: []int{1, 1, 1, 2, 3, 3},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := CollectSlice(MergeSorted(func(a, b int) bool { return a < b }, test.iters...))
+
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestMergeSorted_Stable(t *testing.T) {
+	type record struct {
+		key    int
+		source string
+	}
+
+	actual := CollectSlice(MergeSortedBy(
+		func(r record) int { return r.key },
+		FromSlice([]record{{1, "a"}, {2, "a"}}),
+		FromSlice([]record{{1, "b"}, {2, "b"}}),
+	))
+
+	assert.Equal(t, []record{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, actual)
+}
+
+func TestMergeSorted_Lazy(t *testing.T) {
+	consumed := 0
+	inspect := func(_ *int) { consumed++ }
+
+	merged := MergeSorted(
+		func(a, b int) bool { return a < b },
+		Inspect(FromSlice([]int{1, 3, 5}), inspect),
+		Inspect(FromSlice([]int{2, 4, 6}), inspect),
+	)
+
+	value, _ := merged.Next()
+	assert.Equal(t, 1, value)
+	assert.Equal(t, 2, consumed)
+
+	value, _ = merged.Next()
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 3, consumed)
+}
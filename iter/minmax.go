@@ -0,0 +1,90 @@
+package iter
+
+import (
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+)
+
+// MinBy consumes the provided iterator, returning the least element according
+// to the provided sorting function, or a None value if the iterator yielded no
+// elements.
+//
+// If several elements are equally minimum, the first element is returned.
+func MinBy[T any](iter Interface[T], lessFunc func(a, b T) bool) oxide.Option[T] {
+	return Reduce(iter, func(min T, item *T) T {
+		if lessFunc(*item, min) {
+			return *item
+		}
+
+		return min
+	})
+}
+
+// MaxBy consumes the provided iterator, returning the greatest element
+// according to the provided sorting function, or a None value if the iterator
+// yielded no elements.
+//
+// If several elements are equally maximum, the last element is returned. Ties
+// are detected as elements which are not less than the current maximum, so an
+// element which is incomparable with it, such as a floating point NaN value
+// when lessFunc uses the < operator, also replaces it. Use a lessFunc which
+// orders NaN values, such as cmp.Less, if the elements may contain NaN values.
+func MaxBy[T any](iter Interface[T], lessFunc func(a, b T) bool) oxide.Option[T] {
+	return Reduce(iter, func(max T, item *T) T {
+		if lessFunc(*item, max) {
+			return max
+		}
+
+		return *item
+	})
+}
+
+// MinByKey consumes the provided iterator, returning the element for which the
+// provided function returns the least key, or a None value if the iterator
+// yielded no elements.
+//
+// If several elements are equally minimum, the first element is returned.
+func MinByKey[T any, K constraints.Ordered](iter Interface[T], key func(T) K) oxide.Option[T] {
+	return MinBy(iter, func(a, b T) bool {
+		return key(a) < key(b)
+	})
+}
+
+// MaxByKey consumes the provided iterator, returning the element for which the
+// provided function returns the greatest key, or a None value if the iterator
+// yielded no elements.
+//
+// If several elements are equally maximum, the last element is returned.
+func MaxByKey[T any, K constraints.Ordered](iter Interface[T], key func(T) K) oxide.Option[T] {
+	return MaxBy(iter, func(a, b T) bool {
+		return key(a) < key(b)
+	})
+}
+
+// MinMax consumes the provided iterator, returning both the least and the
+// greatest elements according to the provided sorting function as a pair of
+// [min, max], or a None value if the iterator yielded no elements.
+//
+// Ties are broken in the same manner as MinBy and MaxBy: the first minimum
+// element and the last maximum element are returned. As with MaxBy, a
+// floating point NaN value replaces the current maximum unless lessFunc orders
+// NaN values, such as cmp.Less.
+func MinMax[T any](iter Interface[T], lessFunc func(a, b T) bool) oxide.Option[[2]T] {
+	first, ok := iter.Next()
+	if !ok {
+		return oxide.None[[2]T]()
+	}
+
+	min, max := first, first
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if lessFunc(item, min) {
+			min = item
+		}
+
+		if !lessFunc(item, max) {
+			max = item
+		}
+	}
+
+	return oxide.Some([2]T{min, max})
+}
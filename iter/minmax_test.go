@@ -0,0 +1,64 @@
+package iter
+
+import (
+	"cmp"
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+)
+
+func TestMinByMaxBy(t *testing.T) {
+	testIO := []struct {
+		name      string
+		data      []sortRecord
+		expectMin oxide.Option[sortRecord]
+		expectMax oxide.Option[sortRecord]
+	}{
+		{
+			name:      "should handle an empty iterator",
+			data:      []sortRecord{},
+			expectMin: oxide.None[sortRecord](),
+			expectMax: oxide.None[sortRecord](),
+		},
+		{
+			name:      "should handle a single element",
+			data:      []sortRecord{{1, "a"}},
+			expectMin: oxide.Some(sortRecord{1, "a"}),
+			expectMax: oxide.Some(sortRecord{1, "a"}),
+		},
+		{
+			name:      "should return the first min and last max",
+			data:      sortRecords,
+			expectMin: oxide.Some(sortRecord{1, "b"}),
+			expectMax: oxide.Some(sortRecord{3, "e"}),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectMin, MinBy(FromSlice(test.data), lessByKey))
+			assert.Equal(t, test.expectMax, MaxBy(FromSlice(test.data), lessByKey))
+			assert.Equal(t, test.expectMin, MinByKey(FromSlice(test.data), recordKey))
+			assert.Equal(t, test.expectMax, MaxByKey(FromSlice(test.data), recordKey))
+
+			minMax := MinMax(FromSlice(test.data), lessByKey)
+			assert.Equal(t, test.expectMin.IsSome(), minMax.IsSome())
+			assert.Equal(t, test.expectMin.Value(), minMax.Value()[0])
+			assert.Equal(t, test.expectMax.Value(), minMax.Value()[1])
+		})
+	}
+}
+
+func TestMaxBy_NaN(t *testing.T) {
+	data := []float64{1, math.NaN(), 0}
+
+	// The < operator can not order NaN values, so they are treated as ties.
+	lessOp := func(a, b float64) bool { return a < b }
+	assert.Equal(t, oxide.Some(0.0), MaxBy(FromSlice(data), lessOp))
+
+	// cmp.Less orders NaN values before every other value.
+	assert.Equal(t, oxide.Some(1.0), MaxBy(FromSlice(data), cmp.Less[float64]))
+	assert.Equal(t, 1.0, MinMax(FromSlice(data), cmp.Less[float64]).Value()[1])
+}
@@ -0,0 +1,245 @@
+package iter
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// parChunkSize is the number of elements which are pulled from the source
+// iterator and handed off to a single worker at a time by the parallel APIs.
+const parChunkSize = 256
+
+// parChunk is a contiguous run of the elements of a source iterator, alongside
+// its position amongst the other chunks of that iterator.
+type parChunk[T any] struct {
+	index int
+	items []T
+}
+
+// parSource partitions a source iterator into chunks for the parallel APIs.
+type parSource[T any] struct {
+	chunks <-chan parChunk[T]
+
+	// exhausted is set before chunks is closed if every element of the source
+	// iterator was written onto chunks, rather than the context being done.
+	// It must only be read once chunks has been closed.
+	exhausted bool
+}
+
+// parChunks consumes the provided iterator from a single goroutine,
+// partitioning it into chunks which are written onto the returned source's
+// channel.
+//
+// Iterators are not safe for concurrent use, so this is the only place in
+// which the parallel APIs call Next on the source iterator. The channel is
+// closed once the iterator is exhausted or the context is done, after which
+// Next is never called again.
+func parChunks[T any](ctx context.Context, iter Interface[T]) *parSource[T] {
+	out := make(chan parChunk[T])
+	source := &parSource[T]{chunks: out}
+	go func(out chan<- parChunk[T]) {
+		defer close(out)
+
+		chunk := parChunk[T]{items: make([]T, 0, parChunkSize)}
+		for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+			chunk.items = append(chunk.items, item)
+			if len(chunk.items) < parChunkSize {
+				continue
+			}
+
+			select {
+			case out <- chunk:
+				chunk = parChunk[T]{index: chunk.index + 1, items: make([]T, 0, parChunkSize)}
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if len(chunk.items) > 0 {
+			select {
+			case out <- chunk:
+			case <-ctx.Done():
+				return
+			}
+		}
+		source.exhausted = true
+	}(out)
+	return source
+}
+
+// parWorkers normalizes the requested number of workers, defaulting to
+// GOMAXPROCS when a non-positive value is provided.
+func parWorkers(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+
+	return workers
+}
+
+// ParFold partitions the provided iterator into chunks and folds each chunk
+// concurrently across the specified number of workers. Each chunk is folded
+// into a fresh accumulator returned by init, and the partial results are
+// merged together using combine, in the order in which their chunks were
+// yielded, once all chunks have been folded.
+//
+// Because the partial results are grouped arbitrarily, combine must be
+// associative and init must return an identity value for combine in order for
+// ParFold to produce the same result as Fold. Combine need not be commutative.
+//
+// If workers is less than or equal to zero, GOMAXPROCS workers are used. If
+// the provided context is cancelled before every element has been folded, the
+// context's error is returned alongside the partially folded result.
+func ParFold[T, A any](ctx context.Context, iter Interface[T], workers int, init func() A, fold FoldFunc[T, A], combine func(A, A) A) (A, error) {
+	workers = parWorkers(workers)
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	source := parChunks(ctx, iter)
+
+	type partial struct {
+		index int
+		accum A
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	partials := make([]partial, 0)
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for chunk := range source.chunks {
+				accum := Fold(FromSlice(chunk.items), init(), fold)
+
+				mu.Lock()
+				partials = append(partials, partial{index: chunk.index, accum: accum})
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(partials, func(i, j int) bool {
+		return partials[i].index < partials[j].index
+	})
+
+	result := init()
+	for _, partial := range partials {
+		result = combine(result, partial.accum)
+	}
+
+	// Every chunk which was produced has been folded, so the result is only
+	// incomplete if the context stopped the source from being exhausted.
+	if source.exhausted {
+		return result, nil
+	}
+
+	return result, parent.Err()
+}
+
+// ParForEach consumes the provided iterator, calling the provided closure on
+// each element concurrently across the specified number of workers.
+//
+// The closure may be called from multiple goroutines at once and elements are
+// not guaranteed to be visited in the order in which they were yielded. If
+// workers is less than or equal to zero, GOMAXPROCS workers are used.
+func ParForEach[T any](ctx context.Context, iter Interface[T], workers int, fn func(*T)) error {
+	_, err := ParFold(ctx, iter, workers, func() struct{} { return struct{}{} },
+		func(accum struct{}, item *T) struct{} {
+			fn(item)
+			return accum
+		},
+		func(struct{}, struct{}) struct{} { return struct{}{} },
+	)
+	return err
+}
+
+// ParAny consumes the provided iterator, evaluating the provided predicate
+// concurrently across the specified number of workers. It returns true as soon
+// as any worker finds an element which satisfies the predicate, at which point
+// all other workers stop evaluating elements.
+//
+// If workers is less than or equal to zero, GOMAXPROCS workers are used. If
+// the provided context is cancelled before a result is determined, false is
+// returned alongside the context's error.
+func ParAny[T any](ctx context.Context, iter Interface[T], workers int, fn Predicate[T]) (bool, error) {
+	workers = parWorkers(workers)
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	source := parChunks(ctx, iter)
+
+	var found, interrupted atomic.Bool
+	var once sync.Once
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for chunk := range source.chunks {
+				for index := range chunk.items {
+					if ctx.Err() != nil {
+						interrupted.Store(true)
+						return
+					}
+
+					if fn(&chunk.items[index]) {
+						once.Do(func() {
+							found.Store(true)
+							cancel()
+						})
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The workers may stop early, so the remaining chunks are drained in order
+	// to ensure the source iterator is no longer in use once ParAny returns.
+	cancel()
+	for range source.chunks {
+	}
+
+	if found.Load() {
+		return true, nil
+	}
+
+	// Every element was evaluated unless the context stopped either the source
+	// or one of the workers early.
+	if source.exhausted && !interrupted.Load() {
+		return false, nil
+	}
+
+	return false, parent.Err()
+}
+
+// ParAll consumes the provided iterator, evaluating the provided predicate
+// concurrently across the specified number of workers. It returns false as
+// soon as any worker finds an element which does not satisfy the predicate, at
+// which point all other workers stop evaluating elements.
+//
+// If workers is less than or equal to zero, GOMAXPROCS workers are used. If
+// the provided context is cancelled before a result is determined, false is
+// returned alongside the context's error.
+func ParAll[T any](ctx context.Context, iter Interface[T], workers int, fn Predicate[T]) (bool, error) {
+	anyFailed, err := ParAny(ctx, iter, workers, func(item *T) bool {
+		return !fn(item)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return !anyFailed, nil
+}
@@ -0,0 +1,276 @@
+package iter
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/moogar0880/oxide/assert"
+)
+
+func TestParFold(t *testing.T) {
+	testIO := []struct {
+		name    string
+		iter    Interface[int]
+		workers int
+		expect  int
+	}{
+		{
+			name:    "should fold an empty iterator",
+			iter:    FromSlice([]int{}),
+			workers: 4,
+			expect:  0,
+		},
+		{
+			name:    "should fold a single chunk",
+			iter:    FromSlice([]int{1, 2, 3, 4, 5}),
+			workers: 4,
+			expect:  15,
+		},
+		{
+			name:    "should fold many chunks",
+			iter:    Range(0, 10000),
+			workers: 8,
+			expect:  50005000,
+		},
+		{
+			name:    "should default the number of workers",
+			iter:    Range(0, 1000),
+			workers: 0,
+			expect:  500500,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParFold(context.Background(), test.iter, test.workers,
+				func() int { return 0 },
+				func(accum int, value *int) int { return accum + *value },
+				func(a, b int) int { return a + b },
+			)
+
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestParFold_NonCommutative(t *testing.T) {
+	// Appending is associative but not commutative, so the elements are only
+	// collected in order if the partial results are combined in chunk order.
+	for run := 0; run < 20; run++ {
+		actual, err := ParFold(context.Background(), Range(0, 4999), 4,
+			func() []int { return nil },
+			func(accum []int, value *int) []int { return append(accum, *value) },
+			func(a, b []int) []int { return append(a, b...) },
+		)
+
+		assert.Equal(t, nil, err)
+		assert.Equal(t, CollectSlice(Range(0, 4999)), actual)
+	}
+}
+
+func TestParFold_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParFold(ctx, Range(0, 10000), 4,
+		func() int { return 0 },
+		func(accum int, value *int) int { return accum + *value },
+		func(a, b int) int { return a + b },
+	)
+
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestParFold_CancelledAfterCompletion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Partial results are only combined once every element has been folded,
+	// so cancelling the context from combine must not discard the result.
+	actual, err := ParFold(ctx, Range(0, 10000), 4,
+		func() int { return 0 },
+		func(accum int, value *int) int { return accum + *value },
+		func(a, b int) int {
+			cancel()
+			return a + b
+		},
+	)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 50005000, actual)
+}
+
+func TestParForEach(t *testing.T) {
+	var visited atomic.Int64
+
+	err := ParForEach(context.Background(), Range(0, 5000), 4, func(value *int) {
+		visited.Add(int64(*value))
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(12502500), visited.Load())
+}
+
+func TestParAny(t *testing.T) {
+	testIO := []struct {
+		name   string
+		iter   Interface[int]
+		fn     Predicate[int]
+		expect bool
+	}{
+		{
+			name:   "should find a matching element",
+			iter:   Range(0, 10000),
+			fn:     func(i *int) bool { return *i == 7777 },
+			expect: true,
+		},
+		{
+			name:   "should not find a matching element",
+			iter:   Range(0, 10000),
+			fn:     func(i *int) bool { return *i < 0 },
+			expect: false,
+		},
+		{
+			name:   "should handle an empty iterator",
+			iter:   FromSlice([]int{}),
+			fn:     func(i *int) bool { return true },
+			expect: false,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParAny(context.Background(), test.iter, 4, test.fn)
+
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestParAny_EarlyTermination(t *testing.T) {
+	var evaluated atomic.Int64
+
+	// An unbounded iterator can only be consumed if the workers stop pulling
+	// values once a match has been found.
+	found, err := ParAny(context.Background(), &countingIterator{}, 4, func(i *int) bool {
+		evaluated.Add(1)
+		return *i == 100
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, true, evaluated.Load() >= 100)
+}
+
+func TestParAny_ReleasesIterator(t *testing.T) {
+	source := &countingIterator{}
+	found, err := ParAny(context.Background(), source, 4, func(i *int) bool {
+		return *i == 500
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, found)
+
+	// The source must no longer be advanced once ParAny has returned, which
+	// the race detector also verifies as the source is read unsynchronized.
+	pulled := source.current
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, pulled, source.current)
+}
+
+func TestParAll(t *testing.T) {
+	testIO := []struct {
+		name   string
+		iter   Interface[int]
+		fn     Predicate[int]
+		expect bool
+	}{
+		{
+			name:   "should satisfy the predicate for all elements",
+			iter:   Range(0, 10000),
+			fn:     func(i *int) bool { return *i > 0 },
+			expect: true,
+		},
+		{
+			name:   "should not satisfy the predicate for all elements",
+			iter:   Range(0, 10000),
+			fn:     func(i *int) bool { return *i != 5000 },
+			expect: false,
+		},
+		{
+			name:   "should handle an empty iterator",
+			iter:   FromSlice([]int{}),
+			fn:     func(i *int) bool { return false },
+			expect: true,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParAll(context.Background(), test.iter, 4, test.fn)
+
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestParAll_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	actual, err := ParAll(ctx, &countingIterator{}, 4, func(i *int) bool {
+		return true
+	})
+
+	assert.Equal(t, false, actual)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestParAny_CancelledAfterCompletion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The source fits within a single chunk, so the final element is the last
+	// one evaluated and cancelling from it does not cut the work short.
+	found, err := ParAny(ctx, Range(0, 4), 1, func(i *int) bool {
+		if *i == 4 {
+			cancel()
+		}
+		return false
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, found)
+}
+
+type countingIterator struct {
+	current int
+}
+
+func (i *countingIterator) Next() (int, bool) {
+	i.current++
+	return i.current, true
+}
+
+func BenchmarkFold(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Fold(Range(0, 100000), 0, func(accum int, value *int) int {
+			return accum + *value
+		})
+	}
+}
+
+func BenchmarkParFold(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParFold(context.Background(), Range(0, 100000), 0,
+			func() int { return 0 },
+			func(accum int, value *int) int { return accum + *value },
+			func(a, b int) int { return a + b },
+		)
+	}
+}
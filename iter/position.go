@@ -0,0 +1,96 @@
+package iter
+
+import "github.com/moogar0880/oxide"
+
+// Position consumes the provided iterator until it finds an element which
+// satisfies the provided predicate, returning the index of that element. A
+// None value is returned if no element satisfies the predicate.
+func Position[T any](iter Interface[T], fn Predicate[T]) oxide.Option[int] {
+	for index := 0; ; index++ {
+		item, ok := iter.Next()
+		if !ok {
+			return oxide.None[int]()
+		}
+
+		if fn(&item) {
+			return oxide.Some(index)
+		}
+	}
+}
+
+// RPosition returns the index of the last element yielded by the provided
+// iterator which satisfies the provided predicate. A None value is returned if
+// no element satisfies the predicate.
+//
+// If the iterator implements DoubleEnded, it is searched from the back and the
+// search stops at the first element which satisfies the predicate. Unless the
+// iterator also implements ExactSizer, the elements in front of the match are
+// then consumed in order to determine its index. Any other iterator is
+// consumed in its entirety.
+func RPosition[T any](iter Interface[T], fn Predicate[T]) oxide.Option[int] {
+	doubleEnded, ok := iter.(DoubleEnded[T])
+	if !ok {
+		last := oxide.None[int]()
+		ForEach(Positions(iter, fn), func(index *int) {
+			last = oxide.Some(*index)
+		})
+
+		return last
+	}
+
+	length := -1
+	if sizer, ok := iter.(ExactSizer); ok {
+		length = sizer.Len()
+	}
+
+	for offset := 0; ; offset++ {
+		item, ok := doubleEnded.NextBack()
+		if !ok {
+			return oxide.None[int]()
+		}
+
+		if !fn(&item) {
+			continue
+		}
+
+		if length < 0 {
+			return oxide.Some(Count[T](doubleEnded))
+		}
+
+		return oxide.Some(length - offset - 1)
+	}
+}
+
+type positionsIterator[T any] struct {
+	inner Interface[T]
+	fn    Predicate[T]
+	index int
+}
+
+func (i *positionsIterator[T]) Next() (int, bool) {
+	for item, ok := i.inner.Next(); ok; item, ok = i.inner.Next() {
+		index := i.index
+		i.index++
+
+		if i.fn(&item) {
+			return index, true
+		}
+	}
+
+	return 0, false
+}
+
+// Positions returns an iterator which lazily yields the index of every element
+// of the provided iterator which satisfies the provided predicate.
+func Positions[T any](iter Interface[T], fn Predicate[T]) Interface[int] {
+	return &positionsIterator[T]{inner: iter, fn: fn}
+}
+
+// IndexOf consumes the provided iterator until it finds an element which is
+// equal to the provided value, returning the index of that element. A None
+// value is returned if no element is equal to the value.
+func IndexOf[T comparable](iter Interface[T], value T) oxide.Option[int] {
+	return Position(iter, func(item *T) bool {
+		return *item == value
+	})
+}
@@ -0,0 +1,136 @@
+package iter
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+)
+
+// forwardOnly hides any optional interfaces implemented by the provided
+// iterator, such as DoubleEnded.
+func forwardOnly[T any](iter Interface[T]) Interface[T] {
+	return Map(iter, func(item T) T { return item })
+}
+
+// doubleEndedOnly hides the ExactSizer implementation of a slice iterator.
+type doubleEndedOnly[T any] struct {
+	DoubleEnded[T]
+}
+
+func TestPosition(t *testing.T) {
+	isEven := func(i *int) bool { return *i%2 == 0 }
+
+	testIO := []struct {
+		name      string
+		data      []int
+		first     oxide.Option[int]
+		last      oxide.Option[int]
+		positions []int
+	}{
+		{
+			name:      "should handle an empty iterator",
+			data:      []int{},
+			first:     oxide.None[int](),
+			last:      oxide.None[int](),
+			positions: []int{},
+		},
+		{
+			name:      "should handle no matching elements",
+			data:      []int{1, 3, 5},
+			first:     oxide.None[int](),
+			last:      oxide.None[int](),
+			positions: []int{},
+		},
+		{
+			name:      "should handle a single matching element",
+			data:      []int{1, 4, 5},
+			first:     oxide.Some(1),
+			last:      oxide.Some(1),
+			positions: []int{1},
+		},
+		{
+			name:      "should handle many matching elements",
+			data:      []int{2, 3, 4, 5, 6, 7},
+			first:     oxide.Some(0),
+			last:      oxide.Some(4),
+			positions: []int{0, 2, 4},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.first, Position(FromSlice(test.data), isEven))
+			assert.Equal(t, test.positions, CollectSlice(Positions(FromSlice(test.data), isEven)))
+
+			assert.Equal(t, test.last, RPosition(FromSlice(test.data), isEven))
+			assert.Equal(t, test.last, RPosition(forwardOnly(FromSlice(test.data)), isEven))
+
+			doubleEnded := doubleEndedOnly[int]{FromSlice(test.data).(DoubleEnded[int])}
+			assert.Equal(t, test.last, RPosition[int](doubleEnded, isEven))
+		})
+	}
+}
+
+func TestPosition_ShortCircuits(t *testing.T) {
+	iter := FromSlice([]int{1, 2, 3, 4})
+	assert.Equal(t, oxide.Some(1), Position(iter, func(i *int) bool { return *i == 2 }))
+	assert.Equal(t, []int{3, 4}, CollectSlice(iter))
+
+	iter = FromSlice([]int{1, 2, 3, 4})
+	assert.Equal(t, oxide.Some(1), RPosition(iter, func(i *int) bool { return *i == 2 }))
+	assert.Equal(t, []int{1}, CollectSlice(iter))
+
+	// Positions are yielded lazily, so infinite iterators are supported.
+	positions := Positions[int](&countingIterator{}, func(i *int) bool { return *i%3 == 0 })
+	assert.Equal(t, []int{2, 5, 8}, CollectSlice(Take(positions, 3)))
+}
+
+func TestIndexOf(t *testing.T) {
+	assert.Equal(t, oxide.Some(2), IndexOf(FromSlice([]string{"a", "b", "c", "c"}), "c"))
+	assert.Equal(t, oxide.None[int](), IndexOf(FromSlice([]string{"a", "b"}), "c"))
+}
+
+func TestDoubleEnded(t *testing.T) {
+	slice := FromSlice([]int{1, 2, 3, 4}).(DoubleEnded[int])
+	value, _ := slice.NextBack()
+	assert.Equal(t, 4, value)
+	value, _ = slice.Next()
+	assert.Equal(t, 1, value)
+	assert.Equal(t, 2, slice.(ExactSizer).Len())
+	assert.Equal(t, []int{2, 3}, CollectSlice[int](slice))
+
+	rng := Range(0, 4).(DoubleEnded[int])
+	assert.Equal(t, 4, rng.(ExactSizer).Len())
+	value, _ = rng.NextBack()
+	assert.Equal(t, 4, value)
+	assert.Equal(t, []int{1, 2, 3}, CollectSlice[int](rng))
+	assert.Equal(t, 0, rng.(ExactSizer).Len())
+
+	_, ok := rng.NextBack()
+	assert.Equal(t, false, ok)
+}
+
+func TestRange_LenExtremes(t *testing.T) {
+	testIO := []struct {
+		name   string
+		rng    Interface[int64]
+		expect int
+	}{
+		{name: "should count a range spanning zero", rng: Range[int64](-5, 5), expect: 10},
+		{name: "should count a range of negative values", rng: Range[int64](math.MinInt64, math.MinInt64+3), expect: 3},
+		{name: "should cap a range wider than an int", rng: Range[int64](math.MinInt64, math.MaxInt64), expect: math.MaxInt},
+		{name: "should count a range of exactly MaxInt values", rng: Range[int64](-1, math.MaxInt64-1), expect: math.MaxInt},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.rng.(ExactSizer).Len())
+		})
+	}
+
+	assert.Equal(t, math.MaxInt, Range[uint64](0, math.MaxUint64).(ExactSizer).Len())
+	assert.Equal(t, 2, Range[uint64](math.MaxUint64-2, math.MaxUint64).(ExactSizer).Len())
+	assert.Equal(t, 255, Range[int8](math.MinInt8, math.MaxInt8).(ExactSizer).Len())
+}
@@ -0,0 +1,136 @@
+package iter
+
+import "github.com/moogar0880/oxide"
+
+// Reduce consumes the provided iterator, folding every element into the first
+// element using the provided function, and returns the final value of the
+// accumulator. A None value is returned if the iterator yields no elements.
+func Reduce[T any](iter Interface[T], fn FoldFunc[T, T]) oxide.Option[T] {
+	first, ok := iter.Next()
+	if !ok {
+		return oxide.None[T]()
+	}
+
+	return oxide.Some(Fold(iter, first, fn))
+}
+
+// TryReduce consumes the provided iterator in the same manner as Reduce, but
+// stops as soon as the provided function returns an error, which is returned
+// alongside a None value. Elements after the one which caused the error are
+// not consumed.
+func TryReduce[T any](iter Interface[T], fn func(T, *T) (T, error)) (oxide.Option[T], error) {
+	accum, ok := iter.Next()
+	if !ok {
+		return oxide.None[T](), nil
+	}
+
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		var err error
+		if accum, err = fn(accum, &item); err != nil {
+			return oxide.None[T](), err
+		}
+	}
+
+	return oxide.Some(accum), nil
+}
+
+// TreeFold consumes the provided iterator, combining its elements in a
+// balanced binary tree rather than the linear chain used by Reduce. A None
+// value is returned if the iterator yields no elements.
+//
+// Neighbouring elements are combined first, then neighbouring pairs, and so
+// on, so each element takes part in a number of combinations which only grows
+// with the logarithm of the number of elements. This limits the accumulated
+// error of operations such as floating point addition, and the cost of
+// operations such as concatenation whose cost grows with the size of their
+// operands. The relative order of elements is preserved, so fn only needs to
+// be associative in order for TreeFold to produce the same result as Reduce.
+//
+// Only one partial result per level of the tree is held in memory at any given
+// time.
+func TreeFold[T any](iter Interface[T], fn func(T, T) T) oxide.Option[T] {
+	type partial struct {
+		value T
+		level int
+	}
+
+	stack := make([]partial, 0)
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		// Combine partial results of equal levels, just like carrying the bits
+		// of an incrementing binary counter.
+		stack = append(stack, partial{value: item})
+		for len(stack) > 1 && stack[len(stack)-1].level == stack[len(stack)-2].level {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			stack[len(stack)-1].value = fn(stack[len(stack)-1].value, top.value)
+			stack[len(stack)-1].level++
+		}
+	}
+
+	if len(stack) == 0 {
+		return oxide.None[T]()
+	}
+
+	// The remaining partial results are combined from the smallest to the
+	// largest, each of which holds elements which precede the last.
+	accum := stack[len(stack)-1].value
+	for index := len(stack) - 2; index >= 0; index-- {
+		accum = fn(stack[index].value, accum)
+	}
+
+	return oxide.Some(accum)
+}
+
+// A ControlFlow is returned by the function passed to FoldWhile in order to
+// signal whether the fold should continue with the next element, or stop
+// early. In either case, it carries the new value of the accumulator.
+type ControlFlow[A any] struct {
+	value A
+	done  bool
+}
+
+// Continue returns a ControlFlow which signals that folding should continue
+// with the provided accumulator.
+func Continue[A any](value A) ControlFlow[A] {
+	return ControlFlow[A]{value: value}
+}
+
+// Break returns a ControlFlow which signals that folding should stop, with
+// the provided accumulator as the final result.
+func Break[A any](value A) ControlFlow[A] {
+	return ControlFlow[A]{value: value, done: true}
+}
+
+// IsBreak returns true if this ControlFlow signals that folding should stop.
+func (c ControlFlow[A]) IsBreak() bool {
+	return c.done
+}
+
+// IsContinue returns true if this ControlFlow signals that folding should
+// continue.
+func (c ControlFlow[A]) IsContinue() bool {
+	return !c.done
+}
+
+// Value returns the accumulator carried by this ControlFlow.
+func (c ControlFlow[A]) Value() A {
+	return c.value
+}
+
+// FoldWhile consumes the provided iterator, folding every element into the
+// accumulator (A) for as long as the provided function returns Continue.
+//
+// As soon as the function returns Break, no further elements are consumed and
+// that ControlFlow is returned. Otherwise, a Continue holding the final value
+// of the accumulator is returned once the iterator is exhausted, which allows
+// callers to tell whether the fold stopped early.
+func FoldWhile[T, A any](iter Interface[T], init A, fn func(A, *T) ControlFlow[A]) ControlFlow[A] {
+	flow := Continue(init)
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if flow = fn(flow.value, &item); flow.done {
+			break
+		}
+	}
+
+	return flow
+}
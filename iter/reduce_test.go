@@ -0,0 +1,119 @@
+package iter
+
+import (
+	"errors"
+	"math/bits"
+	"strings"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+)
+
+func TestReduce(t *testing.T) {
+	concat := func(accum string, item *string) string { return accum + *item }
+
+	testIO := []struct {
+		name   string
+		data   []string
+		expect oxide.Option[string]
+	}{
+		{
+			name:   "should handle an empty iterator",
+			data:   []string{},
+			expect: oxide.None[string](),
+		},
+		{
+			name:   "should handle a single element",
+			data:   []string{"a"},
+			expect: oxide.Some("a"),
+		},
+		{
+			name:   "should preserve the order of elements",
+			data:   []string{"a", "b", "c", "d", "e"},
+			expect: oxide.Some("abcde"),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Reduce(FromSlice(test.data), concat))
+
+			actual, err := TryReduce(FromSlice(test.data), func(accum string, item *string) (string, error) {
+				return concat(accum, item), nil
+			})
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expect, actual)
+
+			assert.Equal(t, test.expect, TreeFold(FromSlice(test.data), func(a, b string) string {
+				return a + b
+			}))
+		})
+	}
+}
+
+func TestTryReduce_ShortCircuits(t *testing.T) {
+	errNegative := errors.New("negative")
+	iter := FromSlice([]int{1, 2, -3, 4, 5})
+
+	actual, err := TryReduce(iter, func(accum int, item *int) (int, error) {
+		if *item < 0 {
+			return 0, errNegative
+		}
+
+		return accum + *item, nil
+	})
+	assert.Equal(t, errNegative, err)
+	assert.Equal(t, oxide.None[int](), actual)
+	assert.Equal(t, []int{4, 5}, CollectSlice(iter))
+}
+
+func TestTreeFold(t *testing.T) {
+	type node struct {
+		label string
+		depth int
+	}
+
+	for size := 1; size <= 64; size++ {
+		data := make([]node, size)
+		labels := make([]string, size)
+		for index := range data {
+			labels[index] = string(rune('a' + index%26))
+			data[index] = node{label: labels[index]}
+		}
+
+		actual := TreeFold(FromSlice(data), func(a, b node) node {
+			return node{label: a.label + b.label, depth: max(a.depth, b.depth) + 1}
+		}).Value()
+
+		// A balanced tree over n elements has a depth of ceil(log2(n)).
+		assert.Equal(t, strings.Join(labels, ""), actual.label)
+		assert.Equal(t, bits.Len(uint(size-1)), actual.depth)
+	}
+}
+
+func TestFoldWhile(t *testing.T) {
+	sumUntil := func(limit int) func(int, *int) ControlFlow[int] {
+		return func(accum int, item *int) ControlFlow[int] {
+			if accum+*item > limit {
+				return Break(accum)
+			}
+
+			return Continue(accum + *item)
+		}
+	}
+
+	iter := FromSlice([]int{1, 2, 3, 4, 5})
+	flow := FoldWhile(iter, 0, sumUntil(5))
+	assert.Equal(t, true, flow.IsBreak())
+	assert.Equal(t, 3, flow.Value())
+	assert.Equal(t, []int{4, 5}, CollectSlice(iter))
+
+	flow = FoldWhile(FromSlice([]int{1, 2, 3, 4, 5}), 0, sumUntil(100))
+	assert.Equal(t, true, flow.IsContinue())
+	assert.Equal(t, 15, flow.Value())
+
+	flow = FoldWhile(FromSlice([]int{}), 42, sumUntil(0))
+	assert.Equal(t, true, flow.IsContinue())
+	assert.Equal(t, 42, flow.Value())
+}
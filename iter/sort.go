@@ -0,0 +1,244 @@
+package iter
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/internal/lessheap"
+)
+
+// SortedStable returns an Interface in which all elements are sorted according
+// to the provided sorting function. Unlike Sorted, elements which are equal to
+// one another retain the order in which they were yielded.
+func SortedStable[T any](iter Interface[T], lessFunc func(a, b T) bool) Interface[T] {
+	sorter := &iterSort[T]{lessFunc: lessFunc, data: CollectSlice(iter)}
+	sort.Stable(sorter)
+
+	return FromSlice(sorter.data)
+}
+
+// SortedReverse returns an Interface in which all elements are sorted in the
+// reverse of the order defined by the provided sorting function.
+func SortedReverse[T any](iter Interface[T], lessFunc func(a, b T) bool) Interface[T] {
+	return Sorted(iter, reverseLess(lessFunc))
+}
+
+// SortedStableReverse returns an Interface in which all elements are sorted in
+// the reverse of the order defined by the provided sorting function. Elements
+// which are equal to one another retain the order in which they were yielded.
+func SortedStableReverse[T any](iter Interface[T], lessFunc func(a, b T) bool) Interface[T] {
+	return SortedStable(iter, reverseLess(lessFunc))
+}
+
+// SortedByKey returns an Interface in which all elements are stably sorted in
+// ascending order of the key returned by the provided function.
+//
+// The key function is called on every comparison, so if it is expensive to
+// compute consider using SortedByCachedKey instead.
+func SortedByKey[T any, K constraints.Ordered](iter Interface[T], key func(T) K) Interface[T] {
+	return SortedStable(iter, func(a, b T) bool {
+		return key(a) < key(b)
+	})
+}
+
+// SortedByKeyReverse returns an Interface in which all elements are stably
+// sorted in descending order of the key returned by the provided function.
+func SortedByKeyReverse[T any, K constraints.Ordered](iter Interface[T], key func(T) K) Interface[T] {
+	return SortedStable(iter, func(a, b T) bool {
+		return key(a) > key(b)
+	})
+}
+
+// keyed pairs an element with its pre-computed sort key.
+type keyed[T any, K constraints.Ordered] struct {
+	key   K
+	value T
+}
+
+// SortedByCachedKey returns an Interface in which all elements are stably
+// sorted in ascending order of the key returned by the provided function.
+//
+// Unlike SortedByKey, the key function is only called once per element, at
+// the cost of storing every computed key for the duration of the sort.
+func SortedByCachedKey[T any, K constraints.Ordered](iter Interface[T], key func(T) K) Interface[T] {
+	return sortedByCachedKey(iter, key, func(a, b K) bool { return a < b })
+}
+
+// SortedByCachedKeyReverse returns an Interface in which all elements are
+// stably sorted in descending order of the key returned by the provided
+// function, which is only called once per element.
+func SortedByCachedKeyReverse[T any, K constraints.Ordered](iter Interface[T], key func(T) K) Interface[T] {
+	return sortedByCachedKey(iter, key, func(a, b K) bool { return a > b })
+}
+
+func sortedByCachedKey[T any, K constraints.Ordered](iter Interface[T], key func(T) K, lessFunc func(a, b K) bool) Interface[T] {
+	pairs := CollectSlice(Map(iter, func(value T) keyed[T, K] {
+		return keyed[T, K]{key: key(value), value: value}
+	}))
+
+	sort.Stable(&iterSort[keyed[T, K]]{
+		lessFunc: func(a, b keyed[T, K]) bool { return lessFunc(a.key, b.key) },
+		data:     pairs,
+	})
+
+	return Map(FromSlice(pairs), func(pair keyed[T, K]) T {
+		return pair.value
+	})
+}
+
+// SortedByCmp returns an Interface in which all elements are stably sorted
+// according to the provided three-way comparison function, which must return
+// a negative number when a < b, zero when a == b and a positive number when
+// a > b.
+func SortedByCmp[T any](iter Interface[T], cmp func(a, b T) int) Interface[T] {
+	return SortedStable(iter, func(a, b T) bool {
+		return cmp(a, b) < 0
+	})
+}
+
+// SortedByCmpReverse returns an Interface in which all elements are stably
+// sorted in the reverse of the order defined by the provided three-way
+// comparison function.
+func SortedByCmpReverse[T any](iter Interface[T], cmp func(a, b T) int) Interface[T] {
+	return SortedStable(iter, func(a, b T) bool {
+		return cmp(a, b) > 0
+	})
+}
+
+// reverseLess returns a sorting function which orders elements in the reverse
+// of the order defined by the provided sorting function.
+func reverseLess[T any](lessFunc func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		return lessFunc(b, a)
+	}
+}
+
+// TopK consumes the provided iterator, returning an Interface which yields the
+// k greatest elements according to the provided sorting function, in
+// descending order.
+//
+// Only k elements are held in memory at any given time, which makes TopK
+// considerably cheaper than sorting every element when k is small.
+func TopK[T any](iter Interface[T], k int, lessFunc func(a, b T) bool) Interface[T] {
+	return FromSlice(boundedHeap(iter, k, lessFunc))
+}
+
+// BottomK consumes the provided iterator, returning an Interface which yields
+// the k least elements according to the provided sorting function, in
+// ascending order.
+//
+// Only k elements are held in memory at any given time, which makes BottomK
+// considerably cheaper than sorting every element when k is small.
+func BottomK[T any](iter Interface[T], k int, lessFunc func(a, b T) bool) Interface[T] {
+	return FromSlice(boundedHeap(iter, k, reverseLess(lessFunc)))
+}
+
+// boundedHeap returns the k greatest elements yielded by the provided
+// iterator, according to the provided sorting function, in descending order.
+func boundedHeap[T any](iter Interface[T], k int, lessFunc func(a, b T) bool) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	// The root of the heap is always the least of the k greatest elements seen
+	// so far, and is the element which gets evicted by a greater element.
+	kept := &lessheap.Heap[T]{LessFunc: lessFunc, Data: make([]T, 0)}
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if kept.Len() < k {
+			heap.Push(kept, item)
+		} else if lessFunc(kept.Data[0], item) {
+			kept.Data[0] = item
+			heap.Fix(kept, 0)
+		}
+	}
+
+	out := make([]T, kept.Len())
+	for index := len(out) - 1; index >= 0; index-- {
+		out[index] = heap.Pop(kept).(T)
+	}
+
+	return out
+}
+
+// NthSmallest consumes the provided iterator, returning the element which
+// would be at index n if all elements were sorted according to the provided
+// sorting function.
+//
+// Note: n is zero indexed, meaning n=0 will return the least element. A None
+// value is returned if the iterator yields n or fewer elements.
+func NthSmallest[T any](iter Interface[T], n int, lessFunc func(a, b T) bool) oxide.Option[T] {
+	data := CollectSlice(iter)
+	if n < 0 || n >= len(data) {
+		return oxide.None[T]()
+	}
+
+	return oxide.Some(quickselect(data, n, lessFunc))
+}
+
+// quickselect partially sorts the provided slice in place until the element at
+// index n is the element which would be there if the slice were fully sorted.
+func quickselect[T any](data []T, n int, lessFunc func(a, b T) bool) T {
+	lo, hi := 0, len(data)-1
+	for lo < hi {
+		pivot := data[lo+(hi-lo)/2]
+
+		// Three-way partition the data into elements less than, equal to and
+		// greater than the pivot, so that duplicate values can't degrade the
+		// performance of the selection.
+		lt, index, gt := lo, lo, hi
+		for index <= gt {
+			switch {
+			case lessFunc(data[index], pivot):
+				data[lt], data[index] = data[index], data[lt]
+				lt++
+				index++
+			case lessFunc(pivot, data[index]):
+				data[index], data[gt] = data[gt], data[index]
+				gt--
+			default:
+				index++
+			}
+		}
+
+		switch {
+		case n < lt:
+			hi = lt - 1
+		case n > gt:
+			lo = gt + 1
+		default:
+			return data[n]
+		}
+	}
+
+	return data[n]
+}
+
+// IsSorted consumes the provided iterator, returning a boolean value which
+// indicates whether the elements it yielded were sorted according to the
+// provided sorting function.
+func IsSorted[T any](iter Interface[T], lessFunc func(a, b T) bool) bool {
+	prev, ok := iter.Next()
+	if !ok {
+		return true
+	}
+
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if lessFunc(item, prev) {
+			return false
+		}
+		prev = item
+	}
+
+	return true
+}
+
+// IsSortedBy consumes the provided iterator, returning a boolean value which
+// indicates whether the elements it yielded were sorted in ascending order of
+// the key returned by the provided function.
+func IsSortedBy[T any, K constraints.Ordered](iter Interface[T], key func(T) K) bool {
+	return IsSorted(Map(iter, key), func(a, b K) bool {
+		return a < b
+	})
+}
@@ -0,0 +1,319 @@
+package iter
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+)
+
+type sortRecord struct {
+	key   int
+	label string
+}
+
+var sortRecords = []sortRecord{
+	{3, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {3, "e"}, {2, "f"}, {1, "g"},
+}
+
+func lessByKey(a, b sortRecord) bool { return a.key < b.key }
+
+func cmpByKey(a, b sortRecord) int { return a.key - b.key }
+
+func recordKey(r sortRecord) int { return r.key }
+
+func labels(records []sortRecord) string {
+	out := make([]string, len(records))
+	for i, record := range records {
+		out[i] = record.label
+	}
+
+	return strings.Join(out, "")
+}
+
+func TestSortedStable(t *testing.T) {
+	testIO := []struct {
+		name   string
+		fn     func(Interface[sortRecord]) Interface[sortRecord]
+		expect string
+	}{
+		{
+			name: "should stably sort",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedStable(iter, lessByKey)
+			},
+			expect: "bdgcfae",
+		},
+		{
+			name: "should stably sort in reverse",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedStableReverse(iter, lessByKey)
+			},
+			expect: "aecfbdg",
+		},
+		{
+			name: "should stably sort by key",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedByKey(iter, recordKey)
+			},
+			expect: "bdgcfae",
+		},
+		{
+			name: "should stably sort by key in reverse",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedByKeyReverse(iter, recordKey)
+			},
+			expect: "aecfbdg",
+		},
+		{
+			name: "should stably sort by cached key",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedByCachedKey(iter, recordKey)
+			},
+			expect: "bdgcfae",
+		},
+		{
+			name: "should stably sort by cached key in reverse",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedByCachedKeyReverse(iter, recordKey)
+			},
+			expect: "aecfbdg",
+		},
+		{
+			name: "should stably sort by comparator",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedByCmp(iter, cmpByKey)
+			},
+			expect: "bdgcfae",
+		},
+		{
+			name: "should stably sort by comparator in reverse",
+			fn: func(iter Interface[sortRecord]) Interface[sortRecord] {
+				return SortedByCmpReverse(iter, cmpByKey)
+			},
+			expect: "aecfbdg",
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := CollectSlice(test.fn(FromSlice(sortRecords)))
+			assert.Equal(t, test.expect, labels(actual))
+
+			empty := CollectSlice(test.fn(FromSlice([]sortRecord{})))
+			assert.Equal(t, []sortRecord{}, empty)
+		})
+	}
+}
+
+func TestSortedReverse(t *testing.T) {
+	actual := CollectSlice(SortedReverse(FromSlice([]int{2, 6, 3, 1, 4, 5}), func(a, b int) bool {
+		return a < b
+	}))
+
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, actual)
+}
+
+func TestSortedByCachedKey_CallsKeyOnce(t *testing.T) {
+	calls := 0
+	key := func(i int) int {
+		calls++
+		return -i
+	}
+
+	actual := CollectSlice(SortedByCachedKey(FromSlice([]int{2, 6, 3, 1, 4, 5}), key))
+
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, actual)
+	assert.Equal(t, 6, calls)
+}
+
+func benchmarkSortData() []sortRecord {
+	random := rand.New(rand.NewSource(42))
+	data := make([]sortRecord, 10000)
+	for i := range data {
+		data[i] = sortRecord{key: random.Intn(1000)}
+	}
+
+	return data
+}
+
+func BenchmarkSorted(b *testing.B) {
+	data := benchmarkSortData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = CollectSlice(Sorted(FromSlice(data), lessByKey))
+	}
+}
+
+func BenchmarkSortedStable(b *testing.B) {
+	data := benchmarkSortData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = CollectSlice(SortedStable(FromSlice(data), lessByKey))
+	}
+}
+
+func BenchmarkSortedByKey(b *testing.B) {
+	data := benchmarkSortData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = CollectSlice(SortedByKey(FromSlice(data), recordKey))
+	}
+}
+
+func BenchmarkSortedByCachedKey(b *testing.B) {
+	data := benchmarkSortData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = CollectSlice(SortedByCachedKey(FromSlice(data), recordKey))
+	}
+}
+
+func BenchmarkSortedByCmp(b *testing.B) {
+	data := benchmarkSortData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = CollectSlice(SortedByCmp(FromSlice(data), cmpByKey))
+	}
+}
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestTopK(t *testing.T) {
+	testIO := []struct {
+		name         string
+		data         []int
+		k            int
+		expectTop    []int
+		expectBottom []int
+	}{
+		{
+			name:         "should handle an empty iterator",
+			data:         []int{},
+			k:            3,
+			expectTop:    []int{},
+			expectBottom: []int{},
+		},
+		{
+			name:         "should handle k of zero",
+			data:         []int{1, 2, 3},
+			k:            0,
+			expectTop:    []int{},
+			expectBottom: []int{},
+		},
+		{
+			name:         "should handle k greater than the number of elements",
+			data:         []int{2, 3, 1},
+			k:            5,
+			expectTop:    []int{3, 2, 1},
+			expectBottom: []int{1, 2, 3},
+		},
+		{
+			name:         "should find k elements",
+			data:         []int{5, 9, 1, 7, 3, 8, 2, 6, 4, 0},
+			k:            3,
+			expectTop:    []int{9, 8, 7},
+			expectBottom: []int{0, 1, 2},
+		},
+		{
+			name:         "should handle duplicate elements",
+			data:         []int{5, 5, 1, 5, 1, 3},
+			k:            2,
+			expectTop:    []int{5, 5},
+			expectBottom: []int{1, 1},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			top := CollectSlice(TopK(FromSlice(test.data), test.k, lessInt))
+			assert.Equal(t, test.expectTop, top)
+
+			bottom := CollectSlice(BottomK(FromSlice(test.data), test.k, lessInt))
+			assert.Equal(t, test.expectBottom, bottom)
+		})
+	}
+}
+
+func TestNthSmallest(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	data := make([]int, 500)
+	for i := range data {
+		data[i] = random.Intn(50)
+	}
+	sorted := CollectSlice(Sorted(FromSlice(data), lessInt))
+
+	for _, n := range []int{0, 1, 17, 250, 498, 499} {
+		actual := NthSmallest(FromSlice(append([]int(nil), data...)), n, lessInt)
+		assert.Equal(t, oxide.Some(sorted[n]), actual)
+	}
+
+	assert.Equal(t, oxide.None[int](), NthSmallest(FromSlice(data), 500, lessInt))
+	assert.Equal(t, oxide.None[int](), NthSmallest(FromSlice(data), -1, lessInt))
+	assert.Equal(t, oxide.None[int](), NthSmallest(FromSlice([]int{}), 0, lessInt))
+}
+
+func TestIsSorted(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []int
+		expect bool
+	}{
+		{
+			name:   "should handle an empty iterator",
+			data:   []int{},
+			expect: true,
+		},
+		{
+			name:   "should handle a single element",
+			data:   []int{1},
+			expect: true,
+		},
+		{
+			name:   "should handle sorted elements with duplicates",
+			data:   []int{1, 2, 2, 3},
+			expect: true,
+		},
+		{
+			name:   "should handle unsorted elements",
+			data:   []int{1, 3, 2},
+			expect: false,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, IsSorted(FromSlice(test.data), lessInt))
+			assert.Equal(t, test.expect, IsSortedBy(FromSlice(test.data), func(i int) int { return i }))
+		})
+	}
+}
+
+func TestSortedByKey_OrderedKeys(t *testing.T) {
+	label := func(r sortRecord) string { return r.label }
+
+	actual := CollectSlice(SortedByKeyReverse(FromSlice(sortRecords), label))
+	assert.Equal(t, "gfedcba", labels(actual))
+
+	actual = CollectSlice(SortedByCachedKey(FromSlice(sortRecords), label))
+	assert.Equal(t, "abcdefg", labels(actual))
+
+	assert.Equal(t, true, IsSortedBy(FromSlice([]sortRecord{{3, "a"}, {1, "b"}}), label))
+	assert.Equal(t, oxide.Some(sortRecord{1, "g"}), MaxByKey(FromSlice(sortRecords), label))
+}
+
+func BenchmarkTopK(b *testing.B) {
+	data := benchmarkSortData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = CollectSlice(TopK(FromSlice(data), 10, lessByKey))
+	}
+}
@@ -13,6 +13,22 @@ type SizeHinter interface {
 	SizeHint() (int64, oxide.Option[int64])
 }
 
+// DoubleEnded defines an optional interface that an iterator may implement in
+// order to yield elements from the back of it's underlying collection, as well
+// as the front. An element yielded by NextBack is never yielded by Next, and
+// vice versa.
+type DoubleEnded[T any] interface {
+	Interface[T]
+
+	NextBack() (T, bool)
+}
+
+// ExactSizer defines an optional interface that an iterator may implement in
+// order to express the exact number of elements it has yet to yield.
+type ExactSizer interface {
+	Len() int
+}
+
 type Peekable[T any] interface {
 	Interface[T]
 
@@ -35,6 +35,31 @@ func FromChan[T any](data chan T) *Iterator[T] {
 	return NewIterator[T](iter.FromChannel(data))
 }
 
+// MergeChannels returns a new Iterator instance which yields the values from
+// all the provided channels, in whichever order they become available.
+//
+// For additional details see iter.MergeChannels.
+func MergeChannels[T any](ctx context.Context, chans ...<-chan T) *Iterator[T] {
+	return NewIterator(iter.FromChannelBlocking(iter.MergeChannels(ctx, chans...)))
+}
+
+// Merge returns a new Iterator instance which yields the values from all the
+// provided iterators, in whichever order they become available.
+//
+// For additional details see iter.Merge.
+func Merge[T any](ctx context.Context, iters ...iter.Interface[T]) *Iterator[T] {
+	return NewIterator(iter.Merge(ctx, iters...))
+}
+
+// IndexOf consumes the provided Iterator until it finds an element which is
+// equal to the provided value, returning the index of that element.
+//
+// IndexOf is a function rather than a method because it requires the elements
+// of the Iterator to be comparable. For additional details see iter.IndexOf.
+func IndexOf[T comparable](i *Iterator[T], value T) oxide.Option[int] {
+	return iter.IndexOf(i.inner, value)
+}
+
 // Next implements iter.Interface and allows Iterator[T] to be used as a bare
 // iterator.
 func (i *Iterator[T]) Next() (T, bool) {
@@ -109,12 +134,130 @@ func (i *Iterator[T]) Interleave(other iter.Interface[T]) *Iterator[T] {
 	return NewIterator(iter.Interleave(i.inner, other))
 }
 
+// Merge returns a new Iterator which yields the values from this Iterator and
+// all the provided iterators, in whichever order they become available.
+//
+// For additional details see iter.Merge.
+func (i *Iterator[T]) Merge(ctx context.Context, others ...iter.Interface[T]) *Iterator[T] {
+	return Merge(ctx, append([]iter.Interface[T]{i.inner}, others...)...)
+}
+
+// MergeSorted returns a new Iterator which lazily merges this Iterator and all
+// the provided iterators, each of which must already be sorted according to
+// the provided sorting function, into a single sorted sequence.
+//
+// For additional details see iter.MergeSorted.
+func (i *Iterator[T]) MergeSorted(lessFunc func(i, j T) bool, others ...iter.Interface[T]) *Iterator[T] {
+	return NewIterator(iter.MergeSorted(lessFunc, append([]iter.Interface[T]{i.inner}, others...)...))
+}
+
 // Sorted returns a new Iterator in which all elements are sorted according to
 // the provided sorting function.
 func (i *Iterator[T]) Sorted(lessFunc func(i, j T) bool) *Iterator[T] {
 	return NewIterator(iter.Sorted(i.inner, lessFunc))
 }
 
+// SortedStable returns a new Iterator in which all elements are sorted
+// according to the provided sorting function. Elements which are equal to one
+// another retain the order in which they were yielded.
+func (i *Iterator[T]) SortedStable(lessFunc func(i, j T) bool) *Iterator[T] {
+	return NewIterator(iter.SortedStable(i.inner, lessFunc))
+}
+
+// SortedReverse returns a new Iterator in which all elements are sorted in the
+// reverse of the order defined by the provided sorting function.
+func (i *Iterator[T]) SortedReverse(lessFunc func(i, j T) bool) *Iterator[T] {
+	return NewIterator(iter.SortedReverse(i.inner, lessFunc))
+}
+
+// SortedStableReverse returns a new Iterator in which all elements are sorted
+// in the reverse of the order defined by the provided sorting function.
+// Elements which are equal to one another retain the order in which they were
+// yielded.
+func (i *Iterator[T]) SortedStableReverse(lessFunc func(i, j T) bool) *Iterator[T] {
+	return NewIterator(iter.SortedStableReverse(i.inner, lessFunc))
+}
+
+// SortedByCmp returns a new Iterator in which all elements are stably sorted
+// according to the provided three-way comparison function.
+//
+// For additional details see iter.SortedByCmp.
+func (i *Iterator[T]) SortedByCmp(cmp func(i, j T) int) *Iterator[T] {
+	return NewIterator(iter.SortedByCmp(i.inner, cmp))
+}
+
+// SortedByCmpReverse returns a new Iterator in which all elements are stably
+// sorted in the reverse of the order defined by the provided three-way
+// comparison function.
+func (i *Iterator[T]) SortedByCmpReverse(cmp func(i, j T) int) *Iterator[T] {
+	return NewIterator(iter.SortedByCmpReverse(i.inner, cmp))
+}
+
+// TopK consumes the Iterator, returning a new Iterator which yields the k
+// greatest elements according to the provided sorting function, in descending
+// order.
+func (i *Iterator[T]) TopK(k int, lessFunc func(i, j T) bool) *Iterator[T] {
+	return NewIterator(iter.TopK(i.inner, k, lessFunc))
+}
+
+// BottomK consumes the Iterator, returning a new Iterator which yields the k
+// least elements according to the provided sorting function, in ascending
+// order.
+func (i *Iterator[T]) BottomK(k int, lessFunc func(i, j T) bool) *Iterator[T] {
+	return NewIterator(iter.BottomK(i.inner, k, lessFunc))
+}
+
+// NthSmallest consumes the Iterator, returning the element which would be at
+// index n if all elements were sorted according to the provided sorting
+// function.
+func (i *Iterator[T]) NthSmallest(n int, lessFunc func(i, j T) bool) oxide.Option[T] {
+	return iter.NthSmallest(i.inner, n, lessFunc)
+}
+
+// IsSorted consumes the Iterator, returning a boolean value which indicates
+// whether its elements were sorted according to the provided sorting function.
+func (i *Iterator[T]) IsSorted(lessFunc func(i, j T) bool) bool {
+	return iter.IsSorted(i.inner, lessFunc)
+}
+
+// MinBy consumes the Iterator, returning the least element according to the
+// provided sorting function. If several elements are equally minimum, the
+// first element is returned.
+func (i *Iterator[T]) MinBy(lessFunc func(i, j T) bool) oxide.Option[T] {
+	return iter.MinBy(i.inner, lessFunc)
+}
+
+// MaxBy consumes the Iterator, returning the greatest element according to the
+// provided sorting function. If several elements are equally maximum, the last
+// element is returned.
+func (i *Iterator[T]) MaxBy(lessFunc func(i, j T) bool) oxide.Option[T] {
+	return iter.MaxBy(i.inner, lessFunc)
+}
+
+// MinMax consumes the Iterator, returning both the least and the greatest
+// elements according to the provided sorting function as a pair of [min, max].
+func (i *Iterator[T]) MinMax(lessFunc func(i, j T) bool) oxide.Option[[2]T] {
+	return iter.MinMax(i.inner, lessFunc)
+}
+
+// EqBy consumes the Iterator and the provided iterator, returning a boolean
+// value which indicates whether they yield elements which are equal according
+// to the provided equality function, in the same order.
+//
+// For additional details see iter.EqBy.
+func (i *Iterator[T]) EqBy(other iter.Interface[T], eq func(a, b T) bool) bool {
+	return iter.EqBy(i.inner, other, eq)
+}
+
+// CmpBy consumes the Iterator and the provided iterator, lexicographically
+// comparing the elements which they yield using the provided comparison
+// function.
+//
+// For additional details see iter.CmpBy.
+func (i *Iterator[T]) CmpBy(other iter.Interface[T], cmp func(a, b T) oxide.Ordering) oxide.Ordering {
+	return iter.CmpBy(i.inner, other, cmp)
+}
+
 // Count consumes the Iterator and returns the count of all items in the
 // iterator.
 func (i *Iterator[T]) Count() int {
@@ -142,6 +285,28 @@ func (i *Iterator[T]) Find(fn iter.Predicate[T]) (T, bool) {
 	return iter.Find(i.inner, fn)
 }
 
+// Position searches the Iterator for an element that satisfies the provided
+// predicate, returning its index.
+//
+// For additional details see iter.Position.
+func (i *Iterator[T]) Position(fn iter.Predicate[T]) oxide.Option[int] {
+	return iter.Position(i.inner, fn)
+}
+
+// RPosition returns the index of the last element of the Iterator that
+// satisfies the provided predicate.
+//
+// For additional details see iter.RPosition.
+func (i *Iterator[T]) RPosition(fn iter.Predicate[T]) oxide.Option[int] {
+	return iter.RPosition(i.inner, fn)
+}
+
+// Positions returns a new Iterator which lazily yields the index of every
+// element of this Iterator that satisfies the provided predicate.
+func (i *Iterator[T]) Positions(fn iter.Predicate[T]) *Iterator[int] {
+	return NewIterator(iter.Positions(i.inner, fn))
+}
+
 // SizeHint implements the iter.SizeHinter interface and attempts to provide
 // size hint information about the Iterator.
 //
@@ -161,6 +326,30 @@ func (i *Iterator[T]) Partition(fn iter.Predicate[T]) ([]T, []T) {
 	return iter.Partition(i.inner, fn)
 }
 
+// Reduce consumes the Iterator, folding every element into the first element
+// using the provided function.
+//
+// For additional details see iter.Reduce.
+func (i *Iterator[T]) Reduce(fn iter.FoldFunc[T, T]) oxide.Option[T] {
+	return iter.Reduce(i.inner, fn)
+}
+
+// TryReduce consumes the Iterator in the same manner as Reduce, but stops as
+// soon as the provided function returns an error.
+//
+// For additional details see iter.TryReduce.
+func (i *Iterator[T]) TryReduce(fn func(T, *T) (T, error)) (oxide.Option[T], error) {
+	return iter.TryReduce(i.inner, fn)
+}
+
+// TreeFold consumes the Iterator, combining its elements in a balanced binary
+// tree using the provided function.
+//
+// For additional details see iter.TreeFold.
+func (i *Iterator[T]) TreeFold(fn func(T, T) T) oxide.Option[T] {
+	return iter.TreeFold(i.inner, fn)
+}
+
 // All consumes the Iterator, returning a boolean value which indicates whether
 // all the values yielded by the iterator satisfied the provided predicate.
 func (i *Iterator[T]) All(fn iter.Predicate[T]) bool {
@@ -173,6 +362,32 @@ func (i *Iterator[T]) Any(fn iter.Predicate[T]) bool {
 	return iter.Any(i.inner, fn)
 }
 
+// ParForEach consumes the Iterator and calls the provided closure on each
+// element concurrently across the specified number of workers.
+//
+// For additional details see iter.ParForEach.
+func (i *Iterator[T]) ParForEach(ctx context.Context, workers int, fn func(*T)) error {
+	return iter.ParForEach(ctx, i.inner, workers, fn)
+}
+
+// ParAll consumes the Iterator, evaluating the provided predicate concurrently
+// across the specified number of workers and returning false as soon as any
+// element does not satisfy it.
+//
+// For additional details see iter.ParAll.
+func (i *Iterator[T]) ParAll(ctx context.Context, workers int, fn iter.Predicate[T]) (bool, error) {
+	return iter.ParAll(ctx, i.inner, workers, fn)
+}
+
+// ParAny consumes the Iterator, evaluating the provided predicate concurrently
+// across the specified number of workers and returning true as soon as any
+// element satisfies it.
+//
+// For additional details see iter.ParAny.
+func (i *Iterator[T]) ParAny(ctx context.Context, workers int, fn iter.Predicate[T]) (bool, error) {
+	return iter.ParAny(ctx, i.inner, workers, fn)
+}
+
 // Peekable returns a new iter.Peekable iterator which, in addition to the
 // standard Next() method, also implements Peek() which allows callers to view
 // the next value that an iterator would yield, without consuming it.
@@ -2,6 +2,7 @@ package iterator
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -911,3 +912,218 @@ func TestIterator_Sort(t *testing.T) {
 		})
 	}
 }
+
+func TestIterator_ParForEach(t *testing.T) {
+	var visited atomic.Int64
+
+	err := FromSlice([]int{1, 2, 3, 4, 5}).ParForEach(context.Background(), 2, func(value *int) {
+		visited.Add(int64(*value))
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(15), visited.Load())
+}
+
+func TestIterator_ParAll(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []int
+		fn     iter.Predicate[int]
+		expect bool
+	}{
+		{
+			name:   "should find all are even",
+			data:   []int{2, 4, 6, 8, 10},
+			fn:     assert.IsEven,
+			expect: true,
+		},
+		{
+			name:   "should find all are not positive",
+			data:   []int{-5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5},
+			fn:     assert.IsPositive,
+			expect: false,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := FromSlice(test.data).ParAll(context.Background(), 2, test.fn)
+
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestIterator_ParAny(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []int
+		fn     iter.Predicate[int]
+		expect bool
+	}{
+		{
+			name:   "should find one is even",
+			data:   []int{1, 3, 4, 5},
+			fn:     assert.IsEven,
+			expect: true,
+		},
+		{
+			name:   "should find none are even",
+			data:   []int{1, 3, 5},
+			fn:     assert.IsEven,
+			expect: false,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := FromSlice(test.data).ParAny(context.Background(), 2, test.fn)
+
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestIterator_Merge(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	actual := FromSlice([]int{1, 4}).
+		Merge(ctx, FromSlice([]int{2, 5}), nil, FromSlice([]int{3})).
+		Sorted(func(i, j int) bool { return i < j }).
+		CollectSlice()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, actual)
+}
+
+func TestMergeChannels(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ch1, ch2 := make(chan int, 2), make(chan int, 1)
+	ch1 <- 1
+	ch1 <- 3
+	ch2 <- 2
+	close(ch1)
+	close(ch2)
+
+	actual := MergeChannels(ctx, ch1, nil, ch2).
+		Sorted(func(i, j int) bool { return i < j }).
+		CollectSlice()
+
+	assert.Equal(t, []int{1, 2, 3}, actual)
+}
+
+func TestIterator_MergeSorted(t *testing.T) {
+	actual := FromSlice([]int{1, 4, 7}).
+		MergeSorted(func(i, j int) bool { return i < j }, FromSlice([]int{2, 5}), FromSlice([]int{3, 6})).
+		CollectSlice()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, actual)
+}
+
+func TestIterator_SortedVariants(t *testing.T) {
+	type pair struct{ key, index int }
+	data := []pair{{2, 0}, {1, 1}, {2, 2}, {1, 3}}
+	less := func(i, j pair) bool { return i.key < j.key }
+	cmp := func(i, j pair) int { return i.key - j.key }
+
+	testIO := []struct {
+		name   string
+		fn     func(*Iterator[pair]) *Iterator[pair]
+		expect []pair
+	}{
+		{
+			name:   "should stably sort",
+			fn:     func(i *Iterator[pair]) *Iterator[pair] { return i.SortedStable(less) },
+			expect: []pair{{1, 1}, {1, 3}, {2, 0}, {2, 2}},
+		},
+		{
+			name:   "should stably sort in reverse",
+			fn:     func(i *Iterator[pair]) *Iterator[pair] { return i.SortedStableReverse(less) },
+			expect: []pair{{2, 0}, {2, 2}, {1, 1}, {1, 3}},
+		},
+		{
+			name:   "should stably sort by comparator",
+			fn:     func(i *Iterator[pair]) *Iterator[pair] { return i.SortedByCmp(cmp) },
+			expect: []pair{{1, 1}, {1, 3}, {2, 0}, {2, 2}},
+		},
+		{
+			name:   "should stably sort by comparator in reverse",
+			fn:     func(i *Iterator[pair]) *Iterator[pair] { return i.SortedByCmpReverse(cmp) },
+			expect: []pair{{2, 0}, {2, 2}, {1, 1}, {1, 3}},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.fn(FromSlice(data)).CollectSlice()
+
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+
+	reversed := FromSlice([]int{1, 3, 2}).SortedReverse(func(i, j int) bool { return i < j }).CollectSlice()
+	assert.Equal(t, []int{3, 2, 1}, reversed)
+}
+
+func TestIterator_TopK(t *testing.T) {
+	less := func(i, j int) bool { return i < j }
+
+	top := FromSlice([]int{5, 9, 1, 7, 3}).TopK(2, less).CollectSlice()
+	assert.Equal(t, []int{9, 7}, top)
+
+	bottom := FromSlice([]int{5, 9, 1, 7, 3}).BottomK(2, less).CollectSlice()
+	assert.Equal(t, []int{1, 3}, bottom)
+
+	nth := FromSlice([]int{5, 9, 1, 7, 3}).NthSmallest(2, less)
+	assert.Equal(t, oxide.Some(5), nth)
+
+	assert.Equal(t, true, FromSlice([]int{1, 2, 3}).IsSorted(less))
+	assert.Equal(t, false, FromSlice([]int{3, 2, 1}).IsSorted(less))
+}
+
+func TestIterator_MinMax(t *testing.T) {
+	less := func(i, j int) bool { return i < j }
+
+	assert.Equal(t, oxide.Some(1), FromSlice([]int{5, 1, 7, 3}).MinBy(less))
+	assert.Equal(t, oxide.Some(7), FromSlice([]int{5, 1, 7, 3}).MaxBy(less))
+	assert.Equal(t, oxide.Some([2]int{1, 7}), FromSlice([]int{5, 1, 7, 3}).MinMax(less))
+	assert.Equal(t, oxide.None[[2]int](), FromSlice([]int{}).MinMax(less))
+}
+
+func TestIterator_CmpBy(t *testing.T) {
+	eq := func(i, j int) bool { return i == j }
+	cmp := func(i, j int) oxide.Ordering { return oxide.OrderingOf(i - j) }
+
+	assert.Equal(t, true, FromSlice([]int{1, 2, 3}).EqBy(iter.FromSlice([]int{1, 2, 3}), eq))
+	assert.Equal(t, false, FromSlice([]int{1, 2, 3}).EqBy(iter.FromSlice([]int{1, 2}), eq))
+	assert.Equal(t, oxide.Less, FromSlice([]int{1, 2}).CmpBy(iter.FromSlice([]int{1, 2, 3}), cmp))
+	assert.Equal(t, oxide.Greater, FromSlice([]int{1, 4}).CmpBy(iter.FromSlice([]int{1, 2, 3}), cmp))
+}
+
+func TestIterator_Position(t *testing.T) {
+	isEven := func(i *int) bool { return *i%2 == 0 }
+
+	assert.Equal(t, oxide.Some(1), FromSlice([]int{1, 2, 3, 4, 5}).Position(isEven))
+	assert.Equal(t, oxide.Some(3), FromSlice([]int{1, 2, 3, 4, 5}).RPosition(isEven))
+	assert.Equal(t, oxide.None[int](), FromSlice([]int{1, 3, 5}).RPosition(isEven))
+	assert.Equal(t, []int{1, 3}, FromSlice([]int{1, 2, 3, 4, 5}).Positions(isEven).CollectSlice())
+	assert.Equal(t, oxide.Some(2), IndexOf(FromSlice([]int{1, 2, 3, 4, 5}), 3))
+}
+
+func TestIterator_Reduce(t *testing.T) {
+	add := func(accum int, item *int) int { return accum + *item }
+
+	assert.Equal(t, oxide.Some(15), FromSlice([]int{1, 2, 3, 4, 5}).Reduce(add))
+	assert.Equal(t, oxide.None[int](), FromSlice([]int{}).Reduce(add))
+	assert.Equal(t, oxide.Some(15), FromSlice([]int{1, 2, 3, 4, 5}).TreeFold(func(a, b int) int { return a + b }))
+
+	actual, err := FromSlice([]int{1, 2, 3}).TryReduce(func(accum int, item *int) (int, error) {
+		return add(accum, item), nil
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, oxide.Some(6), actual)
+}
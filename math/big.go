@@ -0,0 +1,140 @@
+package math
+
+import (
+	"math/big"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// SumBigInt returns the sum of all values yielded by the provided iterator.
+// The yielded values are not modified.
+func SumBigInt(iterator iter.Interface[*big.Int]) *big.Int {
+	return iter.Fold(iterator, new(big.Int), func(accum *big.Int, value **big.Int) *big.Int {
+		return accum.Add(accum, *value)
+	})
+}
+
+// ProductBigInt returns the product of all values yielded by the provided
+// iterator, or 1 if the iterator yields no values. The yielded values are not
+// modified.
+func ProductBigInt(iterator iter.Interface[*big.Int]) *big.Int {
+	return iter.Fold(iterator, big.NewInt(1), func(accum *big.Int, value **big.Int) *big.Int {
+		return accum.Mul(accum, *value)
+	})
+}
+
+// MeanBigInt returns the exact arithmetic mean of all values yielded by the
+// provided iterator, or a None value if the iterator yields no values.
+func MeanBigInt(iterator iter.Interface[*big.Int]) oxide.Option[*big.Rat] {
+	count := int64(0)
+	sum := SumBigInt(iter.Inspect(iterator, func(**big.Int) { count++ }))
+	if count == 0 {
+		return oxide.None[*big.Rat]()
+	}
+
+	return oxide.Some(new(big.Rat).SetFrac(sum, big.NewInt(count)))
+}
+
+// SumBigFloat returns the sum of all values yielded by the provided iterator.
+// The yielded values are not modified.
+//
+// The precision of the result is the greatest precision of any of the yielded
+// values, and the sum is rounded to that precision after each addition.
+func SumBigFloat(iterator iter.Interface[*big.Float]) *big.Float {
+	return iter.Fold(iterator, new(big.Float), func(accum *big.Float, value **big.Float) *big.Float {
+		if prec := (*value).Prec(); prec > accum.Prec() {
+			accum.SetPrec(prec)
+		}
+
+		return accum.Add(accum, *value)
+	})
+}
+
+// ProductBigFloat returns the product of all values yielded by the provided
+// iterator, or 1 if the iterator yields no values. The yielded values are not
+// modified.
+//
+// The precision of the result is the greatest precision of any of the yielded
+// values, and the product is rounded to that precision after each
+// multiplication.
+func ProductBigFloat(iterator iter.Interface[*big.Float]) *big.Float {
+	first, ok := iterator.Next()
+	if !ok {
+		return big.NewFloat(1)
+	}
+
+	return iter.Fold(iterator, new(big.Float).Set(first), func(accum *big.Float, value **big.Float) *big.Float {
+		if prec := (*value).Prec(); prec > accum.Prec() {
+			accum.SetPrec(prec)
+		}
+
+		return accum.Mul(accum, *value)
+	})
+}
+
+// MeanBigFloat returns the arithmetic mean of all values yielded by the
+// provided iterator, or a None value if the iterator yields no values.
+//
+// For additional details on the precision of the result see SumBigFloat.
+func MeanBigFloat(iterator iter.Interface[*big.Float]) oxide.Option[*big.Float] {
+	count := int64(0)
+	sum := SumBigFloat(iter.Inspect(iterator, func(**big.Float) { count++ }))
+	if count == 0 {
+		return oxide.None[*big.Float]()
+	}
+
+	return oxide.Some(sum.Quo(sum, new(big.Float).SetInt64(count)))
+}
+
+// SumBigRat returns the exact sum of all values yielded by the provided
+// iterator. The yielded values are not modified.
+func SumBigRat(iterator iter.Interface[*big.Rat]) *big.Rat {
+	return iter.Fold(iterator, new(big.Rat), func(accum *big.Rat, value **big.Rat) *big.Rat {
+		return accum.Add(accum, *value)
+	})
+}
+
+// ProductBigRat returns the exact product of all values yielded by the
+// provided iterator, or 1 if the iterator yields no values. The yielded values
+// are not modified.
+func ProductBigRat(iterator iter.Interface[*big.Rat]) *big.Rat {
+	return iter.Fold(iterator, big.NewRat(1, 1), func(accum *big.Rat, value **big.Rat) *big.Rat {
+		return accum.Mul(accum, *value)
+	})
+}
+
+// MeanBigRat returns the exact arithmetic mean of all values yielded by the
+// provided iterator, or a None value if the iterator yields no values.
+func MeanBigRat(iterator iter.Interface[*big.Rat]) oxide.Option[*big.Rat] {
+	count := int64(0)
+	sum := SumBigRat(iter.Inspect(iterator, func(**big.Rat) { count++ }))
+	if count == 0 {
+		return oxide.None[*big.Rat]()
+	}
+
+	return oxide.Some(sum.Quo(sum, big.NewRat(count, 1)))
+}
+
+// WideningSum returns the sum of all values yielded by the provided iterator,
+// accumulated into a *big.Int so that the sum can never overflow.
+func WideningSum[T constraints.Integer](iterator iter.Interface[T]) *big.Int {
+	return SumBigInt(iter.Map(iterator, toBigInt[T]))
+}
+
+// WideningProduct returns the product of all values yielded by the provided
+// iterator, accumulated into a *big.Int so that the product can never
+// overflow.
+func WideningProduct[T constraints.Integer](iterator iter.Interface[T]) *big.Int {
+	return ProductBigInt(iter.Map(iterator, toBigInt[T]))
+}
+
+// toBigInt converts any integer to a *big.Int without loss.
+func toBigInt[T constraints.Integer](value T) *big.Int {
+	if value < 0 {
+		return big.NewInt(int64(value))
+	}
+
+	return new(big.Int).SetUint64(uint64(value))
+}
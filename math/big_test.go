@@ -0,0 +1,94 @@
+package math
+
+import (
+	gomath "math"
+	"math/big"
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func bigInts(values ...int64) iter.Interface[*big.Int] {
+	return iter.Map(iter.FromSlice(values), big.NewInt)
+}
+
+func bigRats(values ...int64) iter.Interface[*big.Rat] {
+	return iter.Map(iter.FromSlice(values), func(value int64) *big.Rat {
+		return big.NewRat(value, 3)
+	})
+}
+
+func TestBigInt(t *testing.T) {
+	assert.Equal(t, "0", SumBigInt(bigInts()).String())
+	assert.Equal(t, "1", ProductBigInt(bigInts()).String())
+	assert.Equal(t, false, MeanBigInt(bigInts()).IsSome())
+
+	assert.Equal(t, "10", SumBigInt(bigInts(1, 2, 3, 4)).String())
+	assert.Equal(t, "24", ProductBigInt(bigInts(1, 2, 3, 4)).String())
+	assert.Equal(t, "5/2", MeanBigInt(bigInts(1, 2, 3, 4)).Value().String())
+
+	// Sums and products beyond the range of int64.
+	assert.Equal(t, "18446744073709551614", SumBigInt(bigInts(gomath.MaxInt64, gomath.MaxInt64)).String())
+	assert.Equal(t, "85070591730234615847396907784232501249", ProductBigInt(bigInts(gomath.MaxInt64, gomath.MaxInt64)).String())
+}
+
+func TestBigInt_DoesNotModifyInputs(t *testing.T) {
+	values := []*big.Int{big.NewInt(2), big.NewInt(3)}
+
+	SumBigInt(iter.FromSlice(values))
+	ProductBigInt(iter.FromSlice(values))
+
+	assert.Equal(t, "2", values[0].String())
+	assert.Equal(t, "3", values[1].String())
+}
+
+func TestBigFloat(t *testing.T) {
+	floats := func(values ...float64) iter.Interface[*big.Float] {
+		return iter.Map(iter.FromSlice(values), func(value float64) *big.Float {
+			return new(big.Float).SetPrec(200).SetFloat64(value)
+		})
+	}
+
+	assert.Equal(t, "0", SumBigFloat(floats()).String())
+	assert.Equal(t, "1", ProductBigFloat(floats()).String())
+	assert.Equal(t, false, MeanBigFloat(floats()).IsSome())
+
+	// 1e20 + 1 - 1e20 loses the 1 in float64, but not at 200 bits.
+	sum := SumBigFloat(floats(1e20, 1, -1e20))
+	assert.Equal(t, "1", sum.Text('g', 10))
+	assert.Equal(t, uint(200), sum.Prec())
+
+	product := ProductBigFloat(floats(1.5, 2, 4))
+	assert.Equal(t, "12", product.Text('g', 10))
+	assert.Equal(t, uint(200), product.Prec())
+
+	mean := MeanBigFloat(floats(1, 2, 3, 4))
+	assert.Equal(t, "2.5", mean.Value().Text('g', 10))
+}
+
+func TestBigRat(t *testing.T) {
+	assert.Equal(t, "0/1", SumBigRat(bigRats()).String())
+	assert.Equal(t, "1/1", ProductBigRat(bigRats()).String())
+	assert.Equal(t, false, MeanBigRat(bigRats()).IsSome())
+
+	assert.Equal(t, "10/3", SumBigRat(bigRats(1, 2, 3, 4)).String())
+	assert.Equal(t, "8/27", ProductBigRat(bigRats(1, 2, 4)).String())
+	assert.Equal(t, "5/6", MeanBigRat(bigRats(1, 2, 3, 4)).Value().String())
+}
+
+func TestWidening(t *testing.T) {
+	testWidening(t, []int8{gomath.MaxInt8, gomath.MaxInt8, 1}, "255", "16129")
+	testWidening(t, []int8{gomath.MinInt8, gomath.MinInt8}, "-256", "16384")
+	testWidening(t, []uint8{gomath.MaxUint8, gomath.MaxUint8}, "510", "65025")
+	testWidening(t, []int64{gomath.MaxInt64, 1}, "9223372036854775808", "9223372036854775807")
+	testWidening(t, []int64{gomath.MinInt64, -1}, "-9223372036854775809", "9223372036854775808")
+	testWidening(t, []uint64{gomath.MaxUint64, gomath.MaxUint64}, "36893488147419103230", "340282366920938463426481119284349108225")
+	testWidening(t, []int{}, "0", "1")
+}
+
+func testWidening[T constraints.Integer](t *testing.T, data []T, expectSum, expectProduct string) {
+	assert.Equal(t, expectSum, WideningSum(iter.FromSlice(data)).String())
+	assert.Equal(t, expectProduct, WideningProduct(iter.FromSlice(data)).String())
+}
@@ -0,0 +1,117 @@
+package math
+
+import (
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// CheckedSum returns the sum of all values yielded by the provided iterator,
+// or a None value if the sum overflowed T at any point.
+//
+// Consumption of the iterator stops as soon as an overflow is detected.
+func CheckedSum[T constraints.Integer](iterator iter.Interface[T]) oxide.Option[T] {
+	var sum T
+	for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
+		next, ok := checkedAdd(sum, item)
+		if !ok {
+			return oxide.None[T]()
+		}
+		sum = next
+	}
+
+	return oxide.Some(sum)
+}
+
+// CheckedProduct returns the product of all values yielded by the provided
+// iterator, or a None value if the product overflowed T at any point.
+//
+// Consumption of the iterator stops as soon as an overflow is detected.
+func CheckedProduct[T constraints.Integer](iterator iter.Interface[T]) oxide.Option[T] {
+	product := T(1)
+	for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
+		next, ok := checkedMul(product, item)
+		if !ok {
+			return oxide.None[T]()
+		}
+		product = next
+	}
+
+	return oxide.Some(product)
+}
+
+// SaturatingSum returns the sum of all values yielded by the provided
+// iterator. Each addition which would overflow T is clamped to the minimum or
+// maximum value of T instead.
+//
+// Note: saturation is applied to each intermediate sum, so once the sum has
+// been clamped, subsequent values will move the sum away from the bound.
+func SaturatingSum[T constraints.Integer](iterator iter.Interface[T]) T {
+	min, max := integerBounds[T]()
+
+	return iter.Fold(iterator, T(0), func(accum T, value *T) T {
+		sum, ok := checkedAdd(accum, *value)
+		if ok {
+			return sum
+		} else if *value > 0 {
+			return max
+		}
+
+		return min
+	})
+}
+
+// WrappingSum returns the sum of all values yielded by the provided iterator,
+// wrapping around at the boundaries of T when an addition overflows.
+//
+// This is identical to the behavior of Sum, and exists to make the intent to
+// wrap on overflow explicit to readers.
+func WrappingSum[T constraints.Integer](iterator iter.Interface[T]) T {
+	return Sum(iterator)
+}
+
+// checkedAdd returns the sum of a and b, and a boolean value which indicates
+// whether the addition was performed without overflowing T.
+func checkedAdd[T constraints.Integer](a, b T) (T, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return sum, false
+	}
+
+	return sum, true
+}
+
+// checkedMul returns the product of a and b, and a boolean value which
+// indicates whether the multiplication was performed without overflowing T.
+func checkedMul[T constraints.Integer](a, b T) (T, bool) {
+	product := a * b
+	if a == 0 {
+		return product, true
+	}
+
+	// The product of two negative numbers can only be negative if it
+	// overflowed, which also catches the case of -1 * min, for which the
+	// division based check is insufficient.
+	if product/a != b || (a < 0 && b < 0 && product < 0) {
+		return product, false
+	}
+
+	return product, true
+}
+
+// integerBounds returns the minimum and maximum values which can be
+// represented by T.
+func integerBounds[T constraints.Integer]() (min, max T) {
+	max = ^min
+	if max > 0 {
+		// All bits set is only positive for unsigned integers.
+		return min, max
+	}
+
+	max = 1
+	for next := max<<1 | 1; next > max; next = max<<1 | 1 {
+		max = next
+	}
+
+	return ^max, max
+}
@@ -0,0 +1,112 @@
+package math
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestIntegerBounds(t *testing.T) {
+	testBounds[int8](t, gomath.MinInt8, gomath.MaxInt8)
+	testBounds[int16](t, gomath.MinInt16, gomath.MaxInt16)
+	testBounds[int32](t, gomath.MinInt32, gomath.MaxInt32)
+	testBounds[int64](t, gomath.MinInt64, gomath.MaxInt64)
+	testBounds[int](t, gomath.MinInt, gomath.MaxInt)
+	testBounds[uint8](t, 0, gomath.MaxUint8)
+	testBounds[uint16](t, 0, gomath.MaxUint16)
+	testBounds[uint32](t, 0, gomath.MaxUint32)
+	testBounds[uint64](t, 0, gomath.MaxUint64)
+	testBounds[uint](t, 0, gomath.MaxUint)
+}
+
+func testBounds[T constraints.Integer](t *testing.T, expectMin, expectMax T) {
+	min, max := integerBounds[T]()
+
+	assert.Equal(t, expectMin, min)
+	assert.Equal(t, expectMax, max)
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	t.Run("int8", testCheckedArithmetic[int8])
+	t.Run("int16", testCheckedArithmetic[int16])
+	t.Run("int32", testCheckedArithmetic[int32])
+	t.Run("int64", testCheckedArithmetic[int64])
+	t.Run("int", testCheckedArithmetic[int])
+	t.Run("uint8", testCheckedArithmetic[uint8])
+	t.Run("uint16", testCheckedArithmetic[uint16])
+	t.Run("uint32", testCheckedArithmetic[uint32])
+	t.Run("uint64", testCheckedArithmetic[uint64])
+	t.Run("uint", testCheckedArithmetic[uint])
+}
+
+// testCheckedArithmetic exercises the checked, saturating and wrapping
+// reductions at the boundaries of the integer type T.
+func testCheckedArithmetic[T constraints.Integer](t *testing.T) {
+	min, max := integerBounds[T]()
+	signed := min < 0
+
+	sum := func(data ...T) iter.Interface[T] { return iter.FromSlice(data) }
+
+	// Sums which remain within the bounds of T.
+	assert.Equal(t, oxide.Some(T(0)), CheckedSum(sum()))
+	assert.Equal(t, oxide.Some(max), CheckedSum(sum(max-1, 1)))
+	assert.Equal(t, oxide.Some(max), CheckedSum(sum(max)))
+	assert.Equal(t, max, SaturatingSum(sum(max-1, 1)))
+
+	// Sums which overflow the upper bound of T.
+	assert.Equal(t, oxide.None[T](), CheckedSum(sum(max, 1)))
+	assert.Equal(t, oxide.None[T](), CheckedSum(sum(max, max)))
+	assert.Equal(t, max, SaturatingSum(sum(max, 1)))
+	assert.Equal(t, max, SaturatingSum(sum(max, max, max)))
+	assert.Equal(t, min, WrappingSum(sum(max, 1)))
+
+	// Products which remain within the bounds of T.
+	assert.Equal(t, oxide.Some(T(1)), CheckedProduct(sum()))
+	assert.Equal(t, oxide.Some(max), CheckedProduct(sum(max, 1)))
+	assert.Equal(t, oxide.Some(T(0)), CheckedProduct(sum(max, 0, max)))
+	assert.Equal(t, oxide.Some(max-max%2), CheckedProduct(sum(max/2, 2)))
+
+	// Products which overflow the upper bound of T.
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(max, 2)))
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(max/2+1, 2)))
+
+	if !signed {
+		assert.Equal(t, min, SaturatingSum(sum(0)))
+		return
+	}
+
+	var negOne T
+	negOne--
+
+	// Sums which overflow the lower bound of T.
+	assert.Equal(t, oxide.Some(min), CheckedSum(sum(min+1, negOne)))
+	assert.Equal(t, oxide.None[T](), CheckedSum(sum(min, negOne)))
+	assert.Equal(t, min, SaturatingSum(sum(min, negOne)))
+	assert.Equal(t, min, SaturatingSum(sum(min, min)))
+	assert.Equal(t, max, WrappingSum(sum(min, negOne)))
+
+	// Saturation applies to intermediate sums.
+	assert.Equal(t, max-1, SaturatingSum(sum(max, 1, negOne)))
+
+	// Products involving negative numbers at the boundaries of T.
+	assert.Equal(t, oxide.Some(min), CheckedProduct(sum(min, 1)))
+	assert.Equal(t, oxide.Some(-max), CheckedProduct(sum(max, negOne)))
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(min, negOne)))
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(negOne, min)))
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(min, 2)))
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(min/2, min/2)))
+	assert.Equal(t, oxide.None[T](), CheckedProduct(sum(negOne, 2, min/2, negOne)))
+}
+
+func TestCheckedSum_ShortCircuits(t *testing.T) {
+	iterator := iter.FromSlice([]int8{100, 100, 1, 2})
+
+	assert.Equal(t, oxide.None[int8](), CheckedSum(iterator))
+
+	remaining := iter.CollectSlice(iterator)
+	assert.Equal(t, []int8{1, 2}, remaining)
+}
@@ -42,3 +42,52 @@ func ExampleSum() {
 	}
 	// Output: 15
 }
+
+func ExampleMinOption() {
+	{
+		// Define an iterator over our pre-defined data.
+		min := math.MinOption(iter.FromSlice([]int{}))
+		fmt.Println(min.IsSome())
+	}
+	// Output: false
+}
+
+func ExampleMovingAverage() {
+	{
+		// Define an iterator over our pre-defined data.
+		averages := math.MovingAverage(iter.FromSlice([]int{1, 2, 3, 4, 5}), 3)
+		fmt.Println(iter.CollectSlice(averages))
+	}
+	// Output: [2 3 4]
+}
+
+func ExamplePrimes() {
+	{
+		// Take the first ten prime numbers.
+		primes := iter.CollectSlice(iter.Take(math.Primes[int](), 10))
+		fmt.Println(primes)
+	}
+	// Output: [2 3 5 7 11 13 17 19 23 29]
+}
+
+func ExamplePow() {
+	{
+		fmt.Println(math.Pow[int8](2, 6).Unpack())
+		fmt.Println(math.Pow[int8](2, 7).Unpack())
+	}
+	// Output:
+	// 64 true
+	// 0 false
+}
+
+func ExampleCosineSimilarity() {
+	{
+		// Define iterators over our pre-defined vectors.
+		a := iter.FromSlice([]float64{3, 4})
+		b := iter.FromSlice([]float64{4, 3})
+
+		similarity, err := math.CosineSimilarity(a, b)
+		fmt.Println(similarity, err)
+	}
+	// Output: 0.96 <nil>
+}
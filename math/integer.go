@@ -0,0 +1,330 @@
+package math
+
+import (
+	"math/bits"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// GCD returns the greatest common divisor of a and b, which is always
+// non-negative. The GCD of 0 and 0 is 0.
+//
+// Note: the GCD of the minimum value of a signed integer type and either 0 or
+// itself can not be represented by that type, and wraps to the minimum value.
+func GCD[T constraints.Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
+
+// LCM returns the least common multiple of a and b, which is always
+// non-negative. The LCM of 0 and any other number is 0.
+//
+// Note: the LCM wraps around if it can not be represented by T.
+func LCM[T constraints.Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	lcm := a / GCD(a, b) * b
+	if lcm < 0 {
+		return -lcm
+	}
+
+	return lcm
+}
+
+// GCDOf returns the greatest common divisor of all values yielded by the
+// provided iterator, or 0 if the iterator yields no values.
+func GCDOf[T constraints.Integer](iterator iter.Interface[T]) T {
+	return iter.Fold(iterator, T(0), func(accum T, value *T) T {
+		return GCD(accum, *value)
+	})
+}
+
+// LCMOf returns the least common multiple of all values yielded by the
+// provided iterator, or 1 if the iterator yields no values.
+func LCMOf[T constraints.Integer](iterator iter.Interface[T]) T {
+	return iter.Fold(iterator, T(1), func(accum T, value *T) T {
+		return LCM(accum, *value)
+	})
+}
+
+// Pow returns base raised to the power of exp, or a None value if the result
+// overflows T.
+func Pow[T constraints.Integer](base T, exp uint) oxide.Option[T] {
+	result := T(1)
+	for ; exp > 0; exp >>= 1 {
+		var ok bool
+		if exp&1 == 1 {
+			if result, ok = checkedMul(result, base); !ok {
+				return oxide.None[T]()
+			}
+		}
+
+		// The base only needs to be squared if there are further bits of the
+		// exponent to consume, otherwise the square may overflow needlessly.
+		if exp > 1 {
+			if base, ok = checkedMul(base, base); !ok {
+				return oxide.None[T]()
+			}
+		}
+	}
+
+	return oxide.Some(result)
+}
+
+// millerRabinBases are the witnesses which make the Miller-Rabin test
+// deterministic for all 64-bit integers.
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// IsPrime reports whether n is a prime number, using a deterministic variant
+// of the Miller-Rabin primality test which is exact for all 64-bit integers.
+// Negative numbers are never prime.
+func IsPrime[T constraints.Integer](n T) bool {
+	if n < 2 {
+		return false
+	}
+
+	value := uint64(n)
+	for _, p := range millerRabinBases {
+		if value%p == 0 {
+			return value == p
+		}
+	}
+
+	// Write value-1 as d * 2^s, where d is odd.
+	d := value - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s
+
+	for _, base := range millerRabinBases {
+		x := powMod(base, d, value)
+		if x == 1 || x == value-1 {
+			continue
+		}
+
+		composite := true
+		for round := 1; round < s; round++ {
+			x = mulMod(x, x, value)
+			if x == value-1 {
+				composite = false
+				break
+			}
+		}
+
+		if composite {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mulMod returns a * b % m without overflowing.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%m, lo, m)
+	return rem
+}
+
+// powMod returns base^exp % m without overflowing.
+func powMod(base, exp, m uint64) uint64 {
+	result := uint64(1)
+	base %= m
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+		base = mulMod(base, base, m)
+	}
+
+	return result
+}
+
+type primesIterator[T constraints.Integer] struct {
+	candidate T
+
+	// composites maps each upcoming composite number to the primes which
+	// are known to divide it, which is enough to sieve each candidate
+	// without needing to know an upper bound in advance.
+	composites map[T][]T
+	done       bool
+}
+
+func (i *primesIterator[T]) Next() (T, bool) {
+	for !i.done {
+		candidate := i.candidate
+
+		next, ok := checkedAdd(candidate, 1)
+		if !ok {
+			i.done = true
+		}
+		i.candidate = next
+
+		factors, composite := i.composites[candidate]
+		if !composite {
+			// The first composite which isn't already marked by a lesser
+			// prime is the square of the new prime.
+			if square, ok := checkedMul(candidate, candidate); ok {
+				i.composites[square] = []T{candidate}
+			}
+
+			return candidate, true
+		}
+
+		delete(i.composites, candidate)
+		for _, prime := range factors {
+			if multiple, ok := checkedAdd(candidate, prime); ok {
+				i.composites[multiple] = append(i.composites[multiple], prime)
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// Primes returns an iterator which yields every prime number in ascending
+// order, using an incremental Sieve of Eratosthenes.
+//
+// The iterator is exhausted once the next prime number can not be represented
+// by T. The memory used by the sieve grows with the number of primes yielded.
+func Primes[T constraints.Integer]() iter.Interface[T] {
+	return &primesIterator[T]{candidate: 2, composites: make(map[T][]T)}
+}
+
+type fibonacciIterator[T constraints.Integer] struct {
+	current, next T
+
+	// hasNext reports whether next could be represented by T, and done
+	// whether current has already been yielded as the final number.
+	hasNext, done bool
+}
+
+func (i *fibonacciIterator[T]) Next() (T, bool) {
+	if i.done {
+		return 0, false
+	}
+
+	current := i.current
+	if !i.hasNext {
+		i.done = true
+		return current, true
+	}
+
+	sum, ok := checkedAdd(i.current, i.next)
+	i.current, i.next, i.hasNext = i.next, sum, ok
+	return current, true
+}
+
+// Fibonacci returns an iterator which yields the Fibonacci sequence, starting
+// from 0 and 1.
+//
+// The iterator is exhausted once the next Fibonacci number can not be
+// represented by T.
+func Fibonacci[T constraints.Integer]() iter.Interface[T] {
+	return &fibonacciIterator[T]{current: 0, next: 1, hasNext: true}
+}
+
+type factorsIterator[T constraints.Integer] struct {
+	remaining T
+	divisor   T
+
+	// checked reports whether remaining is already known to be composite,
+	// which avoids repeating the primality test after every trial division.
+	checked bool
+}
+
+func (i *factorsIterator[T]) Next() (T, bool) {
+	for i.remaining > 1 {
+		if !i.checked {
+			if IsPrime(i.remaining) {
+				prime := i.remaining
+				i.remaining = 1
+				return prime, true
+			}
+			i.checked = true
+		}
+
+		if i.remaining%i.divisor == 0 {
+			i.remaining /= i.divisor
+			i.checked = false
+			return i.divisor, true
+		}
+
+		if i.divisor == 2 {
+			i.divisor = 3
+		} else {
+			i.divisor += 2
+		}
+	}
+
+	return 0, false
+}
+
+// Factors returns an iterator which yields the prime factors of n in ascending
+// order, with each factor repeated according to its multiplicity. No factors
+// are yielded for numbers less than 2.
+//
+// Factors are found by trial division over odd divisors, stopping once the
+// remaining cofactor is prime, so the running time grows linearly with the
+// second largest prime factor of n. This is at most around sqrt(n)/2 divisions,
+// which is reached when n is the product of two primes of similar size.
+func Factors[T constraints.Integer](n T) iter.Interface[T] {
+	return &factorsIterator[T]{remaining: n, divisor: 2}
+}
+
+type divisorsIterator[T constraints.Integer] struct {
+	n       T
+	divisor T
+
+	// large holds the divisors greater than sqrt(n) which pair with the small
+	// divisors yielded so far, which are replayed in reverse once the trial
+	// divisions are complete.
+	large []T
+}
+
+func (i *divisorsIterator[T]) Next() (T, bool) {
+	// Iterating while divisor <= n / divisor avoids overflowing the square of
+	// the divisor.
+	for i.n > 0 && i.divisor <= i.n/i.divisor {
+		divisor := i.divisor
+		i.divisor++
+
+		if i.n%divisor != 0 {
+			continue
+		}
+
+		if pair := i.n / divisor; pair != divisor {
+			i.large = append(i.large, pair)
+		}
+		return divisor, true
+	}
+
+	if len(i.large) == 0 {
+		return 0, false
+	}
+
+	last := i.large[len(i.large)-1]
+	i.large = i.large[:len(i.large)-1]
+	return last, true
+}
+
+// Divisors returns an iterator which yields every positive divisor of n in
+// ascending order, including 1 and n itself. No divisors are yielded for
+// numbers less than 1.
+//
+// Divisors up to sqrt(n) are found lazily by trial division, and the divisors
+// which pair with them are then yielded in reverse, so the iterator performs
+// at most sqrt(n) divisions in total.
+func Divisors[T constraints.Integer](n T) iter.Interface[T] {
+	return &divisorsIterator[T]{n: n, divisor: 1}
+}
@@ -0,0 +1,193 @@
+package math
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestGCD(t *testing.T) {
+	testIO := []struct {
+		name string
+		a, b int
+		gcd  int
+		lcm  int
+	}{
+		{name: "both zero", a: 0, b: 0, gcd: 0, lcm: 0},
+		{name: "one zero", a: 0, b: 12, gcd: 12, lcm: 0},
+		{name: "coprime", a: 9, b: 28, gcd: 1, lcm: 252},
+		{name: "common factor", a: 12, b: 18, gcd: 6, lcm: 36},
+		{name: "equal", a: 7, b: 7, gcd: 7, lcm: 7},
+		{name: "negative", a: -12, b: 18, gcd: 6, lcm: 36},
+		{name: "both negative", a: -12, b: -18, gcd: 6, lcm: 36},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.gcd, GCD(test.a, test.b))
+			assert.Equal(t, test.gcd, GCD(test.b, test.a))
+			assert.Equal(t, test.lcm, LCM(test.a, test.b))
+			assert.Equal(t, test.lcm, LCM(test.b, test.a))
+		})
+	}
+}
+
+func TestGCDOf(t *testing.T) {
+	assert.Equal(t, 0, GCDOf(iter.FromSlice([]int{})))
+	assert.Equal(t, 1, LCMOf(iter.FromSlice([]int{})))
+	assert.Equal(t, 4, GCDOf(iter.FromSlice([]int{8, -12, 20})))
+	assert.Equal(t, 120, LCMOf(iter.FromSlice([]int{8, -12, 20})))
+	assert.Equal(t, uint16(2520), LCMOf(iter.FromSlice([]uint16{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})))
+}
+
+func TestPow(t *testing.T) {
+	assert.Equal(t, oxide.Some(1), Pow(0, 0))
+	assert.Equal(t, oxide.Some(0), Pow(0, 3))
+	assert.Equal(t, oxide.Some(1024), Pow(2, 10))
+	assert.Equal(t, oxide.Some(-27), Pow(-3, 3))
+	assert.Equal(t, oxide.Some(int8(-128)), Pow(int8(-2), 7))
+	assert.Equal(t, oxide.None[int8](), Pow(int8(2), 7))
+	assert.Equal(t, oxide.Some(uint8(128)), Pow(uint8(2), 7))
+	assert.Equal(t, oxide.None[uint8](), Pow(uint8(2), 8))
+	assert.Equal(t, oxide.Some(uint64(1)<<63), Pow(uint64(2), 63))
+	assert.Equal(t, oxide.None[uint64](), Pow(uint64(2), 64))
+	assert.Equal(t, oxide.Some(uint64(12157665459056928801)), Pow(uint64(3), 40))
+	assert.Equal(t, oxide.None[uint64](), Pow(uint64(3), 41))
+}
+
+// sievePrimes returns every prime number up to and including limit.
+func sievePrimes[T constraints.Integer](limit int) []T {
+	composite := make([]bool, limit+1)
+	primes := make([]T, 0)
+	for n := 2; n <= limit; n++ {
+		if composite[n] {
+			continue
+		}
+
+		primes = append(primes, T(n))
+		for multiple := n * n; multiple <= limit; multiple += n {
+			composite[multiple] = true
+		}
+	}
+
+	return primes
+}
+
+func TestPrimes(t *testing.T) {
+	expect := sievePrimes[int](10000)
+	assert.Equal(t, expect, iter.CollectSlice(iter.Take(Primes[int](), len(expect))))
+
+	// The iterator is exhausted at the largest prime which fits in T.
+	assert.Equal(t, sievePrimes[uint8](gomath.MaxUint8), iter.CollectSlice(Primes[uint8]()))
+	assert.Equal(t, sievePrimes[int8](gomath.MaxInt8), iter.CollectSlice(Primes[int8]()))
+}
+
+func TestIsPrime(t *testing.T) {
+	primes := make(map[int]bool)
+	for _, prime := range sievePrimes[int](10000) {
+		primes[prime] = true
+	}
+
+	for n := -10; n <= 10000; n++ {
+		if IsPrime(n) != primes[n] {
+			t.Fatalf("IsPrime(%d) = %t, expected %t", n, !primes[n], primes[n])
+		}
+	}
+
+	testIO := []struct {
+		name   string
+		n      uint64
+		expect bool
+	}{
+		{name: "carmichael number", n: 561, expect: false},
+		{name: "strong pseudoprime to base 2", n: 2047, expect: false},
+		{name: "strong pseudoprime to bases 2 to 37", n: 3825123056546413051, expect: false},
+		{name: "largest 32-bit prime", n: 4294967291, expect: true},
+		{name: "mersenne prime", n: 2305843009213693951, expect: true},
+		{name: "largest 64-bit prime", n: 18446744073709551557, expect: true},
+		{name: "largest 64-bit integer", n: gomath.MaxUint64, expect: false},
+		{name: "square of a 32-bit prime", n: 4294967291 * 4294967291, expect: false},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, IsPrime(test.n))
+		})
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, iter.CollectSlice(iter.Take(Fibonacci[int](), 10)))
+	assert.Equal(t, []uint8{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 233}, iter.CollectSlice(Fibonacci[uint8]()))
+	assert.Equal(t, []int8{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89}, iter.CollectSlice(Fibonacci[int8]()))
+
+	// F(93) is the largest Fibonacci number which fits in a uint64.
+	fib := iter.CollectSlice(Fibonacci[uint64]())
+	assert.Equal(t, 94, len(fib))
+	assert.Equal(t, uint64(12200160415121876738), fib[93])
+}
+
+func TestFactors(t *testing.T) {
+	testIO := []struct {
+		name   string
+		n      int64
+		expect []int64
+	}{
+		{name: "negative", n: -12, expect: []int64{}},
+		{name: "zero", n: 0, expect: []int64{}},
+		{name: "one", n: 1, expect: []int64{}},
+		{name: "prime", n: 13, expect: []int64{13}},
+		{name: "power of two", n: 64, expect: []int64{2, 2, 2, 2, 2, 2}},
+		{name: "composite", n: 360, expect: []int64{2, 2, 2, 3, 3, 5}},
+		{name: "large prime factor", n: 2 * 2305843009213693951, expect: []int64{2, 2305843009213693951}},
+		{name: "semiprime", n: 1000003 * 999983, expect: []int64{999983, 1000003}},
+		{name: "largest int64", n: gomath.MaxInt64, expect: []int64{7, 7, 73, 127, 337, 92737, 649657}},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, iter.CollectSlice(Factors(test.n)))
+		})
+	}
+
+	for n := int64(2); n < 1000; n++ {
+		product := iter.Fold(Factors(n), int64(1), func(accum int64, factor *int64) int64 {
+			if !IsPrime(*factor) {
+				t.Fatalf("Factors(%d) yielded non-prime factor %d", n, *factor)
+			}
+			return accum * *factor
+		})
+		assert.Equal(t, n, product)
+	}
+}
+
+func TestDivisors(t *testing.T) {
+	testIO := []struct {
+		name   string
+		n      int
+		expect []int
+	}{
+		{name: "negative", n: -12, expect: []int{}},
+		{name: "zero", n: 0, expect: []int{}},
+		{name: "one", n: 1, expect: []int{1}},
+		{name: "prime", n: 13, expect: []int{1, 13}},
+		{name: "square", n: 36, expect: []int{1, 2, 3, 4, 6, 9, 12, 18, 36}},
+		{name: "composite", n: 60, expect: []int{1, 2, 3, 4, 5, 6, 10, 12, 15, 20, 30, 60}},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, iter.CollectSlice(Divisors(test.n)))
+		})
+	}
+
+	assert.Equal(t, []uint8{1, 3, 5, 15, 17, 51, 85, 255}, iter.CollectSlice(Divisors[uint8](gomath.MaxUint8)))
+
+	// Divisors are yielded lazily, so the smallest divisors of a large number
+	// are found without first dividing by every candidate up to its root.
+	assert.Equal(t, []int64{1, 7, 49, 73}, iter.CollectSlice(iter.Take(Divisors[int64](gomath.MaxInt64), 4)))
+}
@@ -1,6 +1,7 @@
 package math
 
 import (
+	"github.com/moogar0880/oxide"
 	"github.com/moogar0880/oxide/constraints"
 	"github.com/moogar0880/oxide/iter"
 )
@@ -21,38 +22,61 @@ func Sum[T constraints.Number](iterator iter.Interface[T]) (value T) {
 }
 
 // Product returns the product of all values yielded by the provided iterator.
-func Product[T constraints.Number](iterator iter.Interface[T]) (value T) {
-	return iter.Fold(iterator, value, func(accum T, value *T) T {
-		if accum == 0 {
-			return *value
-		}
-
+//
+// The product of an iterator which yields no values is 1, the multiplicative
+// identity.
+func Product[T constraints.Number](iterator iter.Interface[T]) T {
+	return iter.Fold(iterator, T(1), func(accum T, value *T) T {
 		return accum * *value
 	})
 }
 
 // Min returns the minimum of all values yielded by the provided iterator.
-func Min[T constraints.Number](iterator iter.Interface[T]) T {
-	min, _ := iterator.Next()
+//
+// Note: the zero value of T is returned if the iterator yields no values,
+// which is indistinguishable from a genuine minimum equal to the zero value. Use
+// MinOption in order to detect an empty iterator.
+func Min[T constraints.Ordered](iterator iter.Interface[T]) T {
+	return MinOption(iterator).Value()
+}
 
-	for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
-		if item < min {
-			min = item
+// Max returns the maximum of all values yielded by the provided iterator.
+//
+// Note: the zero value of T is returned if the iterator yields no values,
+// which is indistinguishable from a genuine maximum equal to the zero value. Use
+// MaxOption in order to detect an empty iterator.
+func Max[T constraints.Ordered](iterator iter.Interface[T]) T {
+	return MaxOption(iterator).Value()
+}
+
+// MinOption returns the minimum of all values yielded by the provided
+// iterator, or a None value if the iterator yields no values.
+//
+// Floating point NaN values never replace the running minimum, so they are
+// only returned if the first value yielded by the iterator is NaN.
+func MinOption[T constraints.Ordered](iterator iter.Interface[T]) oxide.Option[T] {
+	return iter.Reduce(iterator, func(min T, value *T) T {
+		if *value < min {
+			return *value
 		}
-	}
 
-	return min
+		return min
+	})
 }
 
-// Max returns the maximum of all values yielded by the provided iterator.
-func Max[T constraints.Number](iterator iter.Interface[T]) (value T) {
-	max, _ := iterator.Next()
-
-	for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
-		if item > max {
-			max = item
+// MaxOption returns the maximum of all values yielded by the provided
+// iterator, or a None value if the iterator yields no values.
+//
+// Floating point NaN values never replace the running maximum, so they are
+// only returned if the first value yielded by the iterator is NaN.
+func MaxOption[T constraints.Ordered](iterator iter.Interface[T]) oxide.Option[T] {
+	return iter.Reduce(iterator, func(max T, value *T) T {
+		// A floating point NaN value is neither less than, greater than nor
+		// equal to the maximum, so it never replaces it.
+		if max < *value || *value == max {
+			return *value
 		}
-	}
 
-	return max
+		return max
+	})
 }
@@ -1,8 +1,11 @@
 package math
 
 import (
+	gomath "math"
 	"testing"
+	"time"
 
+	"github.com/moogar0880/oxide"
 	"github.com/moogar0880/oxide/assert"
 	"github.com/moogar0880/oxide/iter"
 )
@@ -64,10 +67,20 @@ func TestProduct(t *testing.T) {
 			data:   []int{1, 2, 3, 4, 5},
 			expect: 120,
 		},
+		{
+			name:   "should multiply a zero",
+			data:   []int{2, 0, 3},
+			expect: 0,
+		},
+		{
+			name:   "should multiply a leading zero",
+			data:   []int{0, 2, 3},
+			expect: 0,
+		},
 		{
 			name:   "should multiply 0 numbers",
 			data:   []int{},
-			expect: 0,
+			expect: 1,
 		},
 	}
 
@@ -151,6 +164,89 @@ func TestMax(t *testing.T) {
 	}
 }
 
+func TestMinOption(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []int
+		expect oxide.Option[int]
+	}{
+		{
+			name:   "should find min from 1 number",
+			data:   []int{1},
+			expect: oxide.Some(1),
+		},
+		{
+			name:   "should find min of zero",
+			data:   []int{3, 0, 1},
+			expect: oxide.Some(0),
+		},
+		{
+			name:   "should find min from 0 numbers",
+			data:   []int{},
+			expect: oxide.None[int](),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := MinOption(iter.FromSlice(test.data))
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestMaxOption(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []int
+		expect oxide.Option[int]
+	}{
+		{
+			name:   "should find max from 1 number",
+			data:   []int{1},
+			expect: oxide.Some(1),
+		},
+		{
+			name:   "should find max of zero",
+			data:   []int{-3, 0, -1},
+			expect: oxide.Some(0),
+		},
+		{
+			name:   "should find max from 0 numbers",
+			data:   []int{},
+			expect: oxide.None[int](),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := MaxOption(iter.FromSlice(test.data))
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestMinMaxOrdered(t *testing.T) {
+	words := []string{"pear", "apple", "quince", "fig"}
+	assert.Equal(t, "apple", Min(iter.FromSlice(words)))
+	assert.Equal(t, "quince", Max(iter.FromSlice(words)))
+	assert.Equal(t, oxide.None[string](), MinOption(iter.FromSlice([]string{})))
+
+	durations := []time.Duration{time.Minute, time.Millisecond, time.Hour}
+	assert.Equal(t, time.Millisecond, Min(iter.FromSlice(durations)))
+	assert.Equal(t, time.Hour, Max(iter.FromSlice(durations)))
+
+	assert.Equal(t, []string{"b", "b", "c"}, iter.CollectSlice(MovingMax(iter.FromSlice([]string{"a", "b", "a", "c"}), 2)))
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	// NaN values never replace the running minimum or maximum.
+	data := []float64{1, gomath.NaN(), 0}
+	assert.Equal(t, 1.0, Max(iter.FromSlice(data)))
+	assert.Equal(t, 0.0, Min(iter.FromSlice(data)))
+	assert.Equal(t, oxide.Some(2.0), MaxOption(iter.FromSlice([]float64{2, gomath.NaN()})))
+}
+
 func BenchmarkSum(b *testing.B) {
 	iterator := iter.Range(0, b.N)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,126 @@
+package math
+
+import (
+	gomath "math"
+
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// pairwiseBlockSize is the number of values which are summed naively by
+// SumPairwise before the partial sums are combined pairwise.
+const pairwiseBlockSize = 128
+
+// SumStable returns the sum of all floating point values yielded by the
+// provided iterator using Neumaier's variant of Kahan compensated summation.
+//
+// The rounding error of each addition is tracked in a separate compensation
+// term and added back at the end, so the error of the result does not grow
+// with the number of values summed. SumStable is the most accurate summation
+// in this package, and handles inputs which mix values of vastly different
+// magnitudes which would otherwise cancel one another out, such as
+// [1, 1e100, 1, -1e100], at the cost of a few additional floating point
+// operations per value.
+func SumStable[T constraints.Float](iterator iter.Interface[T]) T {
+	var sum, compensation T
+	for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
+		sum, compensation = neumaierAdd(sum, compensation, item)
+	}
+
+	return sum + compensation
+}
+
+// neumaierAdd adds item to a sum which is tracked alongside the compensation
+// term of Neumaier's summation, returning the updated sum and compensation.
+// The compensation is always zero for integer types, whose additions are
+// exact.
+func neumaierAdd[T constraints.Number](sum, compensation, item T) (T, T) {
+	next := sum + item
+
+	// The rounding error of an infinite or NaN sum is meaningless, and would
+	// otherwise turn the compensation into NaN.
+	if nonFinite(float64(next)) {
+		return next, compensation
+	}
+
+	if magnitude(sum) >= magnitude(item) {
+		compensation += (sum - next) + item
+	} else {
+		compensation += (item - next) + sum
+	}
+
+	return next, compensation
+}
+
+// SumPairwise returns the sum of all floating point values yielded by the
+// provided iterator using pairwise (cascade) summation.
+//
+// Values are summed in blocks, and the block sums are then combined in a
+// balanced binary tree, so the rounding error grows with the logarithm of the
+// number of values rather than linearly as it does for Sum. SumPairwise
+// performs no more floating point operations than Sum, and is preferable to
+// SumStable for long streams of values with similar magnitudes where speed
+// matters more than the last few bits of precision. Unlike SumStable, it does
+// not protect against catastrophic cancellation between values of vastly
+// different magnitudes.
+//
+// Only one partial sum per level of the tree is held in memory at any given
+// time, so the memory used grows with the logarithm of the number of values.
+func SumPairwise[T constraints.Float](iterator iter.Interface[T]) T {
+	type partial struct {
+		sum   T
+		level int
+	}
+
+	stack := make([]partial, 0)
+	for {
+		var block T
+		count := 0
+		for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
+			block += item
+			if count++; count == pairwiseBlockSize {
+				break
+			}
+		}
+
+		if count == 0 {
+			break
+		}
+
+		// Combine partial sums of equal levels, just like carrying the bits of
+		// an incrementing binary counter.
+		stack = append(stack, partial{sum: block})
+		for len(stack) > 1 && stack[len(stack)-1].level == stack[len(stack)-2].level {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			stack[len(stack)-1].sum += top.sum
+			stack[len(stack)-1].level++
+		}
+
+		if count < pairwiseBlockSize {
+			break
+		}
+	}
+
+	// The remaining partial sums are combined from the smallest to the
+	// largest.
+	var sum T
+	for index := len(stack) - 1; index >= 0; index-- {
+		sum += stack[index].sum
+	}
+
+	return sum
+}
+
+// nonFinite reports whether the provided value is infinite or NaN.
+func nonFinite(x float64) bool {
+	return gomath.IsInf(x, 0) || gomath.IsNaN(x)
+}
+
+func magnitude[T constraints.Number](x T) T {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
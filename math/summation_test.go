@@ -0,0 +1,161 @@
+package math
+
+import (
+	gomath "math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// exactSum returns the correctly rounded sum of the provided values, computed
+// using arbitrary precision rational arithmetic.
+func exactSum(data []float64) float64 {
+	sum := new(big.Rat)
+	for _, value := range data {
+		sum.Add(sum, new(big.Rat).SetFloat64(value))
+	}
+
+	exact, _ := sum.Float64()
+	return exact
+}
+
+func repeat(value float64, n int) []float64 {
+	data := make([]float64, n)
+	for index := range data {
+		data[index] = value
+	}
+
+	return data
+}
+
+func TestSumStable(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	mixed := make([]float64, 10000)
+	for index := range mixed {
+		mixed[index] = random.NormFloat64() * gomath.Pow(10, float64(random.Intn(30)-15))
+	}
+
+	testIO := []struct {
+		name string
+		data []float64
+	}{
+		{
+			name: "should sum no values",
+			data: []float64{},
+		},
+		{
+			name: "should sum values which cancel out",
+			data: []float64{1, 1e100, 1, -1e100},
+		},
+		{
+			name: "should sum a small value repeatedly added to a large one",
+			data: append([]float64{1e16}, repeat(1, 1000)...),
+		},
+		{
+			name: "should sum values which are not exactly representable",
+			data: repeat(0.1, 100000),
+		},
+		{
+			name: "should sum values of alternating sign and magnitude",
+			data: []float64{1e20, 3.14159, -1e20, 2.71828, 1e-20, -3.14159},
+		},
+		{
+			name: "should sum values with widely varying magnitudes",
+			data: mixed,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := SumStable(iter.FromSlice(test.data))
+			assert.Equal(t, exactSum(test.data), actual)
+		})
+	}
+}
+
+func TestSumPairwise(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	uniform := make([]float64, 100003)
+	for index := range uniform {
+		uniform[index] = random.Float64()
+	}
+
+	testIO := []struct {
+		name string
+		data []float64
+	}{
+		{
+			name: "should sum no values",
+			data: []float64{},
+		},
+		{
+			name: "should sum fewer values than a block",
+			data: []float64{0.1, 0.2, 0.3},
+		},
+		{
+			name: "should sum exactly one block",
+			data: repeat(0.1, pairwiseBlockSize),
+		},
+		{
+			name: "should sum values which are not exactly representable",
+			data: repeat(0.1, 100000),
+		},
+		{
+			name: "should sum many uniform values",
+			data: uniform,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			exact := exactSum(test.data)
+			actual := SumPairwise(iter.FromSlice(test.data))
+			naive := Sum(iter.FromSlice(test.data))
+
+			// The error of pairwise summation is bounded by roughly
+			// epsilon * log2(n) relative to the sum of absolute values, which
+			// for these inputs is the exact sum itself.
+			bound := gomath.Abs(exact) * 0x1p-52 * (gomath.Log2(float64(len(test.data))+1) + pairwiseBlockSize)
+			assert.Equal(t, true, gomath.Abs(actual-exact) <= bound)
+			assert.Equal(t, true, gomath.Abs(actual-exact) <= gomath.Abs(naive-exact))
+		})
+	}
+}
+
+func TestSumStable_Float32(t *testing.T) {
+	data := append([]float32{1e8}, make([]float32, 1000)...)
+	for index := 1; index < len(data); index++ {
+		data[index] = 1
+	}
+
+	assert.Equal(t, float32(1e8+1000), SumStable(iter.FromSlice(data)))
+	assert.Equal(t, float32(1e8), Sum(iter.FromSlice(data)))
+}
+
+func TestSumStable_Infinite(t *testing.T) {
+	inf := gomath.Inf(1)
+	assert.Equal(t, inf, SumStable(iter.FromSlice([]float64{1, inf, 2})))
+	assert.Equal(t, -inf, SumStable(iter.FromSlice([]float64{-inf, 1e300})))
+	assert.Equal(t, true, gomath.IsNaN(SumStable(iter.FromSlice([]float64{inf, -inf}))))
+}
+
+func BenchmarkSumStable(b *testing.B) {
+	data := repeat(0.1, 100000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = SumStable(iter.FromSlice(data))
+	}
+}
+
+func BenchmarkSumPairwise(b *testing.B) {
+	data := repeat(0.1, 100000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = SumPairwise(iter.FromSlice(data))
+	}
+}
@@ -0,0 +1,129 @@
+package math
+
+import (
+	gomath "math"
+
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// ErrLengthMismatch is returned when two iterators which are treated as
+// vectors yield a different number of values. It is the same error as
+// iter.ErrLengthMismatch.
+var ErrLengthMismatch = iter.ErrLengthMismatch
+
+// Dot consumes the provided iterators, returning the dot product of the
+// vectors which they yield. ErrLengthMismatch is returned if the iterators
+// yield a different number of values.
+func Dot[T constraints.Number](a, b iter.Interface[T]) (T, error) {
+	zipped := iter.ZipExact(a, b)
+	dot := iter.Fold[[2]T](zipped, T(0), func(accum T, pair *[2]T) T {
+		return accum + pair[0]*pair[1]
+	})
+	if err := zipped.Err(); err != nil {
+		return 0, err
+	}
+
+	return dot, nil
+}
+
+// Norm2 returns the Euclidean norm of the vector yielded by the provided
+// iterator, which is the square root of the sum of its squared values.
+func Norm2[T constraints.Number](iterator iter.Interface[T]) float64 {
+	var sumSquares float64
+	iter.ForEach(iterator, func(value *T) {
+		sumSquares += float64(*value) * float64(*value)
+	})
+
+	return gomath.Sqrt(sumSquares)
+}
+
+// NormL1 returns the Manhattan norm of the vector yielded by the provided
+// iterator, which is the sum of its absolute values.
+func NormL1[T constraints.Number](iterator iter.Interface[T]) T {
+	return iter.Fold(iterator, T(0), func(accum T, value *T) T {
+		if *value < 0 {
+			return accum - *value
+		}
+
+		return accum + *value
+	})
+}
+
+// CosineSimilarity consumes the provided iterators, returning the cosine of
+// the angle between the vectors which they yield. ErrLengthMismatch is
+// returned if the iterators yield a different number of values.
+//
+// Note: the cosine similarity is undefined if either vector has a magnitude of
+// zero, in which case NaN is returned.
+func CosineSimilarity[T constraints.Number](a, b iter.Interface[T]) (float64, error) {
+	var dot, sumSquaresA, sumSquaresB float64
+	zipped := iter.ZipExact(a, b)
+	iter.ForEach[[2]T](zipped, func(pair *[2]T) {
+		x, y := float64(pair[0]), float64(pair[1])
+		dot += x * y
+		sumSquaresA += x * x
+		sumSquaresB += y * y
+	})
+	if err := zipped.Err(); err != nil {
+		return 0, err
+	}
+
+	if sumSquaresA == 0 || sumSquaresB == 0 {
+		return gomath.NaN(), nil
+	}
+
+	return dot / (gomath.Sqrt(sumSquaresA) * gomath.Sqrt(sumSquaresB)), nil
+}
+
+// VectorIterator is the iterator returned by the elementwise vector
+// operations. In addition to the standard Next() method, it allows callers to
+// check whether the vectors being combined were of different lengths.
+type VectorIterator[T constraints.Number] struct {
+	zipped *iter.ZipExactIterator[T]
+	op     func(x, y T) T
+}
+
+// Next implements iter.Interface and yields the result of combining the next
+// pair of values. The iterator is exhausted as soon as either vector is.
+func (i *VectorIterator[T]) Next() (T, bool) {
+	pair, ok := i.zipped.Next()
+	if !ok {
+		return 0, false
+	}
+
+	return i.op(pair[0], pair[1]), true
+}
+
+// Err returns ErrLengthMismatch if the iterator was exhausted because one of
+// the vectors yielded fewer values than the other. Err should only be checked
+// once the iterator has been exhausted.
+func (i *VectorIterator[T]) Err() error {
+	return i.zipped.Err()
+}
+
+// Add returns an iterator which yields the elementwise sum of the vectors
+// yielded by a and b.
+func Add[T constraints.Number](a, b iter.Interface[T]) *VectorIterator[T] {
+	return &VectorIterator[T]{zipped: iter.ZipExact(a, b), op: func(x, y T) T { return x + y }}
+}
+
+// Sub returns an iterator which yields the elementwise difference of the
+// vectors yielded by a and b.
+func Sub[T constraints.Number](a, b iter.Interface[T]) *VectorIterator[T] {
+	return &VectorIterator[T]{zipped: iter.ZipExact(a, b), op: func(x, y T) T { return x - y }}
+}
+
+// Mul returns an iterator which yields the elementwise product of the vectors
+// yielded by a and b.
+func Mul[T constraints.Number](a, b iter.Interface[T]) *VectorIterator[T] {
+	return &VectorIterator[T]{zipped: iter.ZipExact(a, b), op: func(x, y T) T { return x * y }}
+}
+
+// Scale returns an iterator which yields each value of the provided iterator
+// multiplied by factor.
+func Scale[T constraints.Number](iterator iter.Interface[T], factor T) iter.Interface[T] {
+	return iter.Map(iterator, func(value T) T {
+		return value * factor
+	})
+}
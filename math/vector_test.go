@@ -0,0 +1,106 @@
+package math
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestDot(t *testing.T) {
+	testIO := []struct {
+		name   string
+		a, b   []int
+		expect int
+		err    error
+	}{
+		{name: "empty", a: []int{}, b: []int{}, expect: 0},
+		{name: "orthogonal", a: []int{1, 0}, b: []int{0, 1}, expect: 0},
+		{name: "parallel", a: []int{1, 2, 3}, b: []int{4, -5, 6}, expect: 12},
+		{name: "shorter left", a: []int{1, 2}, b: []int{1, 2, 3}, err: ErrLengthMismatch},
+		{name: "shorter right", a: []int{1, 2, 3}, b: []int{1, 2}, err: ErrLengthMismatch},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			dot, err := Dot(iter.FromSlice(test.a), iter.FromSlice(test.b))
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.expect, dot)
+		})
+	}
+}
+
+func TestNorms(t *testing.T) {
+	assert.Equal(t, 0.0, Norm2(iter.FromSlice([]int{})))
+	assert.Equal(t, 5.0, Norm2(iter.FromSlice([]int{3, -4})))
+	assert.Equal(t, 13.0, Norm2(iter.FromSlice([]float64{-3, 4, 12})))
+
+	assert.Equal(t, 0, NormL1(iter.FromSlice([]int{})))
+	assert.Equal(t, 7, NormL1(iter.FromSlice([]int{3, -4})))
+	assert.Equal(t, 19.5, NormL1(iter.FromSlice([]float64{-3.5, 4, -12})))
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	testIO := []struct {
+		name   string
+		a, b   []float64
+		expect float64
+		err    error
+	}{
+		{name: "identical", a: []float64{1, 2, 3}, b: []float64{1, 2, 3}, expect: 1},
+		{name: "scaled", a: []float64{1, 2, 3}, b: []float64{2, 4, 6}, expect: 1},
+		{name: "opposite", a: []float64{1, 2}, b: []float64{-1, -2}, expect: -1},
+		{name: "orthogonal", a: []float64{1, 0}, b: []float64{0, 5}, expect: 0},
+		{name: "diagonal", a: []float64{1, 0}, b: []float64{1, 1}, expect: gomath.Sqrt2 / 2},
+		{name: "length mismatch", a: []float64{1, 0}, b: []float64{1}, err: ErrLengthMismatch},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			similarity, err := CosineSimilarity(iter.FromSlice(test.a), iter.FromSlice(test.b))
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, true, gomath.Abs(test.expect-similarity) < 1e-12)
+		})
+	}
+
+	t.Run("zero magnitude", func(t *testing.T) {
+		similarity, err := CosineSimilarity(iter.FromSlice([]int{0, 0}), iter.FromSlice([]int{1, 2}))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, gomath.IsNaN(similarity))
+	})
+}
+
+func TestElementwise(t *testing.T) {
+	testIO := []struct {
+		name   string
+		op     func(a, b iter.Interface[int]) *VectorIterator[int]
+		a, b   []int
+		expect []int
+		err    error
+	}{
+		{name: "add", op: Add[int], a: []int{1, 2, 3}, b: []int{4, 5, 6}, expect: []int{5, 7, 9}},
+		{name: "sub", op: Sub[int], a: []int{1, 2, 3}, b: []int{4, 5, 6}, expect: []int{-3, -3, -3}},
+		{name: "mul", op: Mul[int], a: []int{1, 2, 3}, b: []int{4, 5, 6}, expect: []int{4, 10, 18}},
+		{name: "empty", op: Add[int], a: []int{}, b: []int{}, expect: []int{}},
+		{name: "shorter left", op: Add[int], a: []int{1}, b: []int{4, 5}, expect: []int{5}, err: ErrLengthMismatch},
+		{name: "shorter right", op: Mul[int], a: []int{1, 2}, b: []int{4}, expect: []int{4}, err: ErrLengthMismatch},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			vector := test.op(iter.FromSlice(test.a), iter.FromSlice(test.b))
+			assert.Equal(t, test.expect, iter.CollectSlice[int](vector))
+			assert.Equal(t, test.err, vector.Err())
+
+			// Exhausted iterators do not consume any further values.
+			_, ok := vector.Next()
+			assert.Equal(t, false, ok)
+		})
+	}
+}
+
+func TestScale(t *testing.T) {
+	assert.Equal(t, []int{}, iter.CollectSlice(Scale(iter.FromSlice([]int{}), 3)))
+	assert.Equal(t, []float64{-1, 0, 2.5}, iter.CollectSlice(Scale(iter.FromSlice([]float64{-2, 0, 5}), 0.5)))
+}
@@ -0,0 +1,287 @@
+package math
+
+import (
+	gomath "math"
+
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// ring is a fixed size buffer which retains the most recent values pushed
+// into it.
+type ring[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+func newRing[T any](size int) *ring[T] {
+	return &ring[T]{data: make([]T, size)}
+}
+
+// push adds a value to the buffer, returning the oldest value which was
+// evicted to make room for it, if the buffer was already full.
+func (r *ring[T]) push(value T) (evicted T, ok bool) {
+	if r.size == len(r.data) {
+		evicted, ok = r.data[r.head], true
+	} else {
+		r.size++
+	}
+
+	r.data[r.head] = value
+	r.head = (r.head + 1) % len(r.data)
+	return evicted, ok
+}
+
+func (r *ring[T]) full() bool {
+	return r.size == len(r.data)
+}
+
+// wrapped reports whether the most recent push filled the final slot of the
+// buffer, which happens once every len(data) pushes.
+func (r *ring[T]) wrapped() bool {
+	return r.head == 0
+}
+
+type movingSumIterator[T constraints.Number] struct {
+	inner  iter.Interface[T]
+	window *ring[T]
+
+	// sum and compensation hold a running Neumaier sum of the window, which
+	// keeps the rounding error of adding and evicting values from growing
+	// with the length of the stream.
+	sum, compensation T
+}
+
+func (i *movingSumIterator[T]) Next() (T, bool) {
+	for item, ok := i.inner.Next(); ok; item, ok = i.inner.Next() {
+		// Subtracting an infinite or NaN value can not undo its effect, since
+		// Inf-Inf is NaN, so the sum is recomputed once one leaves the window.
+		evicted, _ := i.window.push(item)
+		if nonFinite(float64(evicted)) {
+			i.sum, i.compensation = 0, 0
+			for _, value := range i.window.data[:i.window.size] {
+				i.sum, i.compensation = neumaierAdd(i.sum, i.compensation, value)
+			}
+		} else {
+			i.sum, i.compensation = neumaierAdd(i.sum, i.compensation, item)
+			i.sum, i.compensation = neumaierAdd(i.sum, i.compensation, -evicted)
+		}
+
+		if i.window.full() {
+			return i.sum + i.compensation, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// MovingSum returns an iterator which yields the sum of each window of values
+// of the specified size yielded by the provided iterator.
+//
+// The first value is yielded once a full window of values has been consumed,
+// so an iterator which yields n values produces n-window+1 sums. No values are
+// yielded if window is less than or equal to zero.
+func MovingSum[T constraints.Number](iterator iter.Interface[T], window int) iter.Interface[T] {
+	if window <= 0 {
+		return iter.FromSlice([]T{})
+	}
+
+	return &movingSumIterator[T]{inner: iterator, window: newRing[T](window)}
+}
+
+// MovingAverage returns an iterator which yields the simple moving average of
+// each window of values of the specified size yielded by the provided
+// iterator.
+//
+// For additional details on how windows are yielded see MovingSum.
+func MovingAverage[T constraints.Number](iterator iter.Interface[T], window int) iter.Interface[float64] {
+	asFloat := iter.Map(iterator, func(value T) float64 {
+		return float64(value)
+	})
+
+	return iter.Map(MovingSum(asFloat, window), func(sum float64) float64 {
+		return sum / float64(window)
+	})
+}
+
+type emaIterator[T constraints.Number] struct {
+	inner   iter.Interface[T]
+	alpha   float64
+	average float64
+	started bool
+}
+
+func (i *emaIterator[T]) Next() (float64, bool) {
+	value, ok := i.inner.Next()
+	if !ok {
+		return 0, false
+	}
+
+	if !i.started {
+		i.started = true
+		i.average = float64(value)
+	} else {
+		i.average += i.alpha * (float64(value) - i.average)
+	}
+
+	return i.average, true
+}
+
+// ExponentialMovingAverage returns an iterator which yields the exponential
+// moving average of the values yielded by the provided iterator, using the
+// smoothing factor alpha, which should be in the range (0, 1].
+//
+// The first average is the first value itself, and each subsequent average is
+// alpha * value + (1 - alpha) * previous, so a greater alpha discounts older
+// values more quickly.
+func ExponentialMovingAverage[T constraints.Number](iterator iter.Interface[T], alpha float64) iter.Interface[float64] {
+	return &emaIterator[T]{inner: iterator, alpha: alpha}
+}
+
+// indexed pairs a value with the index at which it was yielded.
+type indexed[T any] struct {
+	index int
+	value T
+}
+
+type movingExtremeIterator[T constraints.Ordered] struct {
+	inner  iter.Interface[T]
+	window int
+	index  int
+
+	// keep reports whether the value a should remain in the deque when the
+	// value b is yielded after it.
+	keep func(a, b T) bool
+
+	// deque holds the candidate extremes of the current window in the order
+	// in which they were yielded. The front of the deque is always the
+	// extreme of the current window.
+	deque []indexed[T]
+}
+
+func (i *movingExtremeIterator[T]) Next() (T, bool) {
+	for item, ok := i.inner.Next(); ok; item, ok = i.inner.Next() {
+		// Any candidates which can never be the extreme of a window again,
+		// because the new value will outlive them, are discarded.
+		for len(i.deque) > 0 && !i.keep(i.deque[len(i.deque)-1].value, item) {
+			i.deque = i.deque[:len(i.deque)-1]
+		}
+		i.deque = append(i.deque, indexed[T]{index: i.index, value: item})
+
+		// The front of the deque is discarded once it has left the window.
+		if i.deque[0].index <= i.index-i.window {
+			i.deque = i.deque[1:]
+		}
+
+		i.index++
+		if i.index >= i.window {
+			return i.deque[0].value, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// MovingMin returns an iterator which yields the minimum of each window of
+// values of the specified size yielded by the provided iterator.
+//
+// Each minimum is computed in amortized constant time using a monotonic
+// deque. For additional details on how windows are yielded see MovingSum.
+func MovingMin[T constraints.Ordered](iterator iter.Interface[T], window int) iter.Interface[T] {
+	if window <= 0 {
+		return iter.FromSlice([]T{})
+	}
+
+	return &movingExtremeIterator[T]{
+		inner:  iterator,
+		window: window,
+		keep:   func(a, b T) bool { return a < b },
+	}
+}
+
+// MovingMax returns an iterator which yields the maximum of each window of
+// values of the specified size yielded by the provided iterator.
+//
+// Each maximum is computed in amortized constant time using a monotonic
+// deque. For additional details on how windows are yielded see MovingSum.
+func MovingMax[T constraints.Ordered](iterator iter.Interface[T], window int) iter.Interface[T] {
+	if window <= 0 {
+		return iter.FromSlice([]T{})
+	}
+
+	return &movingExtremeIterator[T]{
+		inner:  iterator,
+		window: window,
+		keep:   func(a, b T) bool { return a > b },
+	}
+}
+
+type rollingStdDevIterator[T constraints.Number] struct {
+	inner  iter.Interface[T]
+	window *ring[float64]
+	mean   float64
+	m2     float64
+}
+
+func (i *rollingStdDevIterator[T]) Next() (float64, bool) {
+	for item, ok := i.inner.Next(); ok; item, ok = i.inner.Next() {
+		x := float64(item)
+		evicted, replaced := i.window.push(x)
+
+		// Welford's algorithm, extended to also remove the value which left
+		// the window so that the window size remains constant.
+		// The running values are recomputed from scratch once per window, which
+		// bounds the rounding error of removing values to a single window.
+		if i.window.wrapped() || nonFinite(evicted) {
+			i.recompute()
+		} else if replaced {
+			n := float64(len(i.window.data))
+			mean := i.mean + (x-evicted)/n
+			i.m2 += (x - evicted) * (x - mean + evicted - i.mean)
+			i.mean = mean
+		} else {
+			delta := x - i.mean
+			i.mean += delta / float64(i.window.size)
+			i.m2 += delta * (x - i.mean)
+		}
+
+		if i.window.full() {
+			return gomath.Sqrt(gomath.Max(i.m2, 0) / float64(i.window.size)), true
+		}
+	}
+
+	return 0, false
+}
+
+// recompute computes the mean and sum of squared differences from the mean of
+// the values currently held by the window from scratch.
+func (i *rollingStdDevIterator[T]) recompute() {
+	values := i.window.data[:i.window.size]
+
+	i.mean = 0
+	for _, value := range values {
+		i.mean += value
+	}
+	i.mean /= float64(len(values))
+
+	i.m2 = 0
+	for _, value := range values {
+		i.m2 += (value - i.mean) * (value - i.mean)
+	}
+}
+
+// RollingStdDev returns an iterator which yields the population standard
+// deviation of each window of values of the specified size yielded by the
+// provided iterator.
+//
+// For additional details on how windows are yielded see MovingSum.
+func RollingStdDev[T constraints.Number](iterator iter.Interface[T], window int) iter.Interface[float64] {
+	if window <= 0 {
+		return iter.FromSlice([]float64{})
+	}
+
+	return &rollingStdDevIterator[T]{inner: iterator, window: newRing[float64](window)}
+}
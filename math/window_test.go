@@ -0,0 +1,184 @@
+package math
+
+import (
+	gomath "math"
+	"math/rand"
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// windows returns every window of the specified size over the provided data.
+func windows(data []int, size int) [][]int {
+	out := make([][]int, 0)
+	for start := 0; start+size <= len(data); start++ {
+		out = append(out, data[start:start+size])
+	}
+
+	return out
+}
+
+func TestMovingWindows(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	data := make([]int, 200)
+	for index := range data {
+		data[index] = random.Intn(100) - 50
+	}
+
+	testIO := []struct {
+		name   string
+		data   []int
+		window int
+	}{
+		{
+			name:   "should handle no values",
+			data:   []int{},
+			window: 3,
+		},
+		{
+			name:   "should handle fewer values than the window",
+			data:   []int{1, 2},
+			window: 3,
+		},
+		{
+			name:   "should handle a window of one",
+			data:   []int{3, 1, 2},
+			window: 1,
+		},
+		{
+			name:   "should handle duplicate values",
+			data:   []int{2, 2, 1, 1, 2, 2},
+			window: 2,
+		},
+		{
+			name:   "should handle random values",
+			data:   data,
+			window: 7,
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			expectSum, expectMin, expectMax := []int{}, []int{}, []int{}
+			expectAvg := []float64{}
+			for _, window := range windows(test.data, test.window) {
+				expectSum = append(expectSum, Sum(iter.FromSlice(window)))
+				expectMin = append(expectMin, Min(iter.FromSlice(window)))
+				expectMax = append(expectMax, Max(iter.FromSlice(window)))
+				expectAvg = append(expectAvg, float64(Sum(iter.FromSlice(window)))/float64(test.window))
+			}
+
+			assert.Equal(t, expectSum, iter.CollectSlice(MovingSum(iter.FromSlice(test.data), test.window)))
+			assert.Equal(t, expectMin, iter.CollectSlice(MovingMin(iter.FromSlice(test.data), test.window)))
+			assert.Equal(t, expectMax, iter.CollectSlice(MovingMax(iter.FromSlice(test.data), test.window)))
+			assert.Equal(t, expectAvg, iter.CollectSlice(MovingAverage(iter.FromSlice(test.data), test.window)))
+		})
+	}
+}
+
+func TestMovingWindows_InvalidWindow(t *testing.T) {
+	data := []int{1, 2, 3}
+
+	assert.Equal(t, []int{}, iter.CollectSlice(MovingSum(iter.FromSlice(data), 0)))
+	assert.Equal(t, []int{}, iter.CollectSlice(MovingMin(iter.FromSlice(data), -1)))
+	assert.Equal(t, []int{}, iter.CollectSlice(MovingMax(iter.FromSlice(data), 0)))
+	assert.Equal(t, []float64{}, iter.CollectSlice(MovingAverage(iter.FromSlice(data), 0)))
+	assert.Equal(t, []float64{}, iter.CollectSlice(RollingStdDev(iter.FromSlice(data), 0)))
+}
+
+func TestExponentialMovingAverage(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []float64
+		alpha  float64
+		expect []float64
+	}{
+		{
+			name:   "should handle no values",
+			data:   []float64{},
+			alpha:  0.5,
+			expect: []float64{},
+		},
+		{
+			name:   "should average values",
+			data:   []float64{10, 20, 30, 10},
+			alpha:  0.5,
+			expect: []float64{10, 15, 22.5, 16.25},
+		},
+		{
+			name:   "should track the latest value with an alpha of one",
+			data:   []float64{10, 20, 30},
+			alpha:  1,
+			expect: []float64{10, 20, 30},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := iter.CollectSlice(ExponentialMovingAverage(iter.FromSlice(test.data), test.alpha))
+			assert.Equal(t, test.expect, actual)
+		})
+	}
+}
+
+func TestRollingStdDev(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	data := make([]int, 500)
+	for index := range data {
+		data[index] = random.Intn(1000) + 1e6
+	}
+
+	stdDev := func(window []int) float64 {
+		mean := float64(Sum(iter.FromSlice(window))) / float64(len(window))
+
+		var squares float64
+		for _, value := range window {
+			squares += (float64(value) - mean) * (float64(value) - mean)
+		}
+
+		return gomath.Sqrt(squares / float64(len(window)))
+	}
+
+	for _, size := range []int{1, 2, 10, 50} {
+		actual := iter.CollectSlice(RollingStdDev(iter.FromSlice(data), size))
+		expect := windows(data, size)
+		assert.Equal(t, len(expect), len(actual))
+
+		for index, window := range expect {
+			if gomath.Abs(stdDev(window)-actual[index]) > 1e-6 {
+				t.Errorf("window %d: expected %v, actual %v", index, stdDev(window), actual[index])
+			}
+		}
+	}
+}
+
+func TestMovingWindows_NonFinite(t *testing.T) {
+	inf := gomath.Inf(1)
+	data := []float64{1, inf, 2, 3, gomath.NaN(), 4, 5, 6}
+
+	// Once the infinite and NaN values leave the window, the outputs must
+	// recover rather than remaining NaN forever.
+	sums := iter.CollectSlice(MovingSum(iter.FromSlice(data), 2))
+	assert.Equal(t, 7, len(sums))
+	assert.Equal(t, []float64{inf, inf, 5}, sums[:3])
+	assert.Equal(t, []float64{9, 11}, sums[5:])
+
+	stdDevs := iter.CollectSlice(RollingStdDev(iter.FromSlice(data), 2))
+	assert.Equal(t, 7, len(stdDevs))
+	assert.Equal(t, true, gomath.IsNaN(stdDevs[0]))
+	assert.Equal(t, 0.5, stdDevs[2])
+	assert.Equal(t, []float64{0.5, 0.5}, stdDevs[5:])
+}
+
+func TestMovingWindows_Cancellation(t *testing.T) {
+	// A huge value is cancelled out by the values which follow it once it
+	// leaves the window, which a naive running sum gets wrong.
+	data := []float64{1e20, 1, 1, 1, 1, 1}
+
+	sums := iter.CollectSlice(MovingSum(iter.FromSlice(data), 3))
+	assert.Equal(t, []float64{1e20, 3, 3, 3}, sums)
+
+	stdDevs := iter.CollectSlice(RollingStdDev(iter.FromSlice(data), 3))
+	assert.Equal(t, 0.0, stdDevs[len(stdDevs)-1])
+}
@@ -0,0 +1,80 @@
+package oxide
+
+import (
+	"cmp"
+
+	"github.com/moogar0880/oxide/constraints"
+)
+
+// An Ordering is the result of comparing two values, describing whether the
+// first value is less than, equal to, or greater than the second.
+//
+// Orderings are ordered themselves, such that Less < Equal < Greater, and can
+// be converted to and from the conventional integer representation used by
+// functions such as strings.Compare by way of a simple type conversion.
+type Ordering int
+
+const (
+	// Less indicates that the first value is less than the second.
+	Less Ordering = -1
+	// Equal indicates that both values are equal.
+	Equal Ordering = 0
+	// Greater indicates that the first value is greater than the second.
+	Greater Ordering = 1
+)
+
+// OrderingOf returns the Ordering represented by the provided integer, where
+// any negative number is Less, zero is Equal and any positive number is
+// Greater.
+func OrderingOf(cmp int) Ordering {
+	switch {
+	case cmp < 0:
+		return Less
+	case cmp > 0:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+// Compare returns the Ordering of two values according to their natural
+// ordering.
+//
+// Floating point NaN values are considered equal to one another and less than
+// any other value, including negative infinity, so that Compare is a strict
+// weak ordering even in their presence.
+func Compare[T constraints.Ordered](a, b T) Ordering {
+	return OrderingOf(cmp.Compare(a, b))
+}
+
+// Reverse returns the opposite Ordering, swapping Less and Greater.
+func (o Ordering) Reverse() Ordering {
+	return -o
+}
+
+// Then returns this Ordering if it is not Equal, otherwise it returns the
+// provided Ordering. This allows comparisons on multiple fields to be chained
+// together, for example:
+//
+//	oxide.OrderingOf(a.Year - b.Year).Then(oxide.OrderingOf(a.Month - b.Month))
+func (o Ordering) Then(other Ordering) Ordering {
+	if o != Equal {
+		return o
+	}
+
+	return other
+}
+
+// String implements fmt.Stringer.
+func (o Ordering) String() string {
+	switch o {
+	case Less:
+		return "Less"
+	case Equal:
+		return "Equal"
+	case Greater:
+		return "Greater"
+	default:
+		return "Ordering(invalid)"
+	}
+}
@@ -0,0 +1,65 @@
+package oxide
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide/assert"
+)
+
+func TestOrderingOf(t *testing.T) {
+	testIO := []struct {
+		name   string
+		cmp    int
+		expect Ordering
+	}{
+		{name: "negative", cmp: -42, expect: Less},
+		{name: "zero", cmp: 0, expect: Equal},
+		{name: "positive", cmp: 7, expect: Greater},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := OrderingOf(test.cmp)
+			assert.Equal(t, test.expect, actual)
+			assert.Equal(t, test.expect.Reverse(), OrderingOf(-test.cmp))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	testIO := []struct {
+		name   string
+		a, b   float64
+		expect Ordering
+	}{
+		{name: "less", a: 1, b: 2, expect: Less},
+		{name: "equal", a: 2, b: 2, expect: Equal},
+		{name: "greater", a: 3, b: 2, expect: Greater},
+		{name: "both NaN", a: math.NaN(), b: math.NaN(), expect: Equal},
+		{name: "NaN before negative infinity", a: math.NaN(), b: math.Inf(-1), expect: Less},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Compare(test.a, test.b))
+			assert.Equal(t, test.expect.Reverse(), Compare(test.b, test.a))
+		})
+	}
+
+	assert.Equal(t, Less, Compare("apple", "banana"))
+}
+
+func TestOrdering_Then(t *testing.T) {
+	assert.Equal(t, Less, Less.Then(Greater))
+	assert.Equal(t, Greater, Greater.Then(Less))
+	assert.Equal(t, Less, Equal.Then(Less))
+	assert.Equal(t, Equal, Equal.Then(Equal))
+}
+
+func TestOrdering_String(t *testing.T) {
+	assert.Equal(t, "Less", Less.String())
+	assert.Equal(t, "Equal", Equal.String())
+	assert.Equal(t, "Greater", Greater.String())
+	assert.Equal(t, "Ordering(invalid)", Ordering(2).String())
+}
@@ -0,0 +1,3 @@
+// Package stats provides descriptive statistics which are computed over the
+// values yielded by iterators.
+package stats
@@ -0,0 +1,63 @@
+package stats_test
+
+import (
+	"fmt"
+
+	"github.com/moogar0880/oxide/iter"
+	"github.com/moogar0880/oxide/stats"
+)
+
+func ExampleMean() {
+	{
+		// Define an iterator over our pre-defined data.
+		mean := stats.Mean(iter.FromSlice([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+		fmt.Println(mean.Unpack())
+	}
+	// Output: 5 true
+}
+
+func ExampleStdDev() {
+	{
+		// Define an iterator over our pre-defined data.
+		stdDev := stats.StdDev(iter.FromSlice([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+		fmt.Println(stdDev.Unpack())
+	}
+	// Output: 2 true
+}
+
+func ExamplePercentile() {
+	{
+		// Define an iterator over our pre-defined data.
+		latencies := iter.FromSlice([]int{15, 20, 35, 40, 50})
+
+		p40 := stats.Percentile(latencies, 40, stats.Linear)
+		fmt.Println(p40.Unpack())
+	}
+	// Output: 29 true
+}
+
+func ExampleCorrelation() {
+	{
+		// Define iterators over our pre-defined data.
+		x := iter.FromSlice([]float64{1, 2, 3, 4})
+		y := iter.FromSlice([]float64{2, 4, 6, 8})
+
+		correlation, err := stats.Correlation(x, y)
+		fmt.Println(correlation.Value(), err)
+	}
+	// Output: 1 <nil>
+}
+
+func ExampleKLL() {
+	{
+		// Summarize each shard of our data with its own sketch.
+		left, right := stats.NewKLL(0), stats.NewKLL(0)
+		left.ObserveAll(iter.FromSlice([]float64{1, 2, 3, 4, 5}))
+		right.ObserveAll(iter.FromSlice([]float64{6, 7, 8, 9, 10}))
+
+		// Combine the per-shard summaries into a single sketch.
+		left.Merge(right)
+		fmt.Println(left.Count(), left.Quantile(0.5).Value())
+	}
+	// Output: 10 5
+}
@@ -0,0 +1,284 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// histogramVersion identifies the binary serialization format of a Histogram.
+const histogramVersion = 1
+
+// ErrIncompatibleBuckets is returned when merging two histograms which do not
+// share the same bucket boundaries.
+var ErrIncompatibleBuckets = errors.New("stats: histograms have incompatible buckets")
+
+// LinearBuckets returns count bucket boundaries, the first of which is start
+// and each of which is width greater than the last.
+func LinearBuckets(start, width float64, count int) []float64 {
+	bounds := make([]float64, count)
+	for index := range bounds {
+		bounds[index] = start + width*float64(index)
+	}
+
+	return bounds
+}
+
+// ExponentialBuckets returns count bucket boundaries, the first of which is
+// start and each of which is factor times greater than the last.
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	bounds := make([]float64, count)
+	for index := range bounds {
+		bounds[index] = start * math.Pow(factor, float64(index))
+	}
+
+	return bounds
+}
+
+// A Bucket describes the number of values observed by a Histogram which were
+// greater than Lower and less than or equal to Upper.
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count uint64
+}
+
+// Histogram is a streaming summary which counts the observed values which fall
+// into each of a fixed set of buckets.
+//
+// Histograms with identical bucket boundaries can be combined via Merge, which
+// makes them well suited to summarizing separate shards of a stream.
+//
+// Quantiles estimated by a Histogram are interpolated linearly within the
+// bucket in which they fall, so the estimate is always within the bounds of
+// that bucket. The absolute error of an estimate is therefore at most the
+// width of the bucket it falls in: for LinearBuckets this is the bucket width,
+// and for ExponentialBuckets the error relative to the estimate is at most
+// factor - 1.
+type Histogram struct {
+	bounds   []float64
+	counts   []uint64
+	n        uint64
+	sum      float64
+	min, max float64
+}
+
+// NewHistogram returns a new, empty Histogram which uses the provided bucket
+// boundaries. Each boundary is the inclusive upper bound of a bucket, and an
+// additional bucket counts values greater than the final boundary.
+//
+// Boundaries are sorted, and duplicate or NaN boundaries are discarded.
+func NewHistogram(bounds ...float64) *Histogram {
+	sorted := make([]float64, 0, len(bounds))
+	for _, bound := range bounds {
+		if !math.IsNaN(bound) {
+			sorted = append(sorted, bound)
+		}
+	}
+	sort.Float64s(sorted)
+
+	unique := sorted[:0]
+	for index, bound := range sorted {
+		if index == 0 || bound != sorted[index-1] {
+			unique = append(unique, bound)
+		}
+	}
+
+	return &Histogram{
+		bounds: unique,
+		counts: make([]uint64, len(unique)+1),
+		min:    math.Inf(1),
+		max:    math.Inf(-1),
+	}
+}
+
+// Observe adds the provided value to the histogram. NaN values are ignored.
+func (h *Histogram) Observe(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+
+	h.counts[sort.SearchFloat64s(h.bounds, x)]++
+	h.n++
+	h.sum += x
+	h.min = math.Min(h.min, x)
+	h.max = math.Max(h.max, x)
+}
+
+// ObserveAll consumes the provided iterator, adding each of its values to the
+// histogram.
+func (h *Histogram) ObserveAll(iterator iter.Interface[float64]) {
+	iter.ForEach(iterator, func(x *float64) {
+		h.Observe(*x)
+	})
+}
+
+// Count returns the number of values which have been observed by the
+// histogram.
+func (h *Histogram) Count() uint64 {
+	return h.n
+}
+
+// Sum returns the sum of the values which have been observed by the
+// histogram.
+func (h *Histogram) Sum() float64 {
+	return h.sum
+}
+
+// Buckets returns an iterator over the buckets of the histogram, in ascending
+// order. The first and last buckets are bounded by negative and positive
+// infinity respectively.
+func (h *Histogram) Buckets() iter.Interface[Bucket] {
+	buckets := make([]Bucket, len(h.counts))
+	for index, count := range h.counts {
+		buckets[index] = Bucket{
+			Lower: math.Inf(-1),
+			Upper: math.Inf(1),
+			Count: count,
+		}
+
+		if index > 0 {
+			buckets[index].Lower = h.bounds[index-1]
+		}
+
+		if index < len(h.bounds) {
+			buckets[index].Upper = h.bounds[index]
+		}
+	}
+
+	return iter.FromSlice(buckets)
+}
+
+// Quantile returns an estimate of the qth quantile of the observed values,
+// where q is in the range [0, 1].
+//
+// The first and last buckets are treated as being bounded by the least and
+// greatest values which were observed. A None value is returned if no values
+// have been observed, or if q is outside the range [0, 1].
+func (h *Histogram) Quantile(q float64) oxide.Option[float64] {
+	if h.n == 0 || q < 0 || q > 1 || math.IsNaN(q) {
+		return oxide.None[float64]()
+	}
+
+	target := q * float64(h.n)
+
+	var cumulative uint64
+	for index, count := range h.counts {
+		if count == 0 || float64(cumulative+count) < target {
+			cumulative += count
+			continue
+		}
+
+		lower, upper := h.min, h.max
+		if index > 0 {
+			lower = math.Max(lower, h.bounds[index-1])
+		}
+
+		if index < len(h.bounds) {
+			upper = math.Min(upper, h.bounds[index])
+		}
+
+		fraction := (target - float64(cumulative)) / float64(count)
+		return oxide.Some(lower + (upper-lower)*fraction)
+	}
+
+	return oxide.Some(h.max)
+}
+
+// Merge folds the counts of the provided histogram into this histogram. An
+// ErrIncompatibleBuckets error is returned if the histograms do not share the
+// same bucket boundaries, in which case this histogram is left unchanged.
+func (h *Histogram) Merge(other *Histogram) error {
+	if len(h.bounds) != len(other.bounds) {
+		return ErrIncompatibleBuckets
+	}
+
+	for index, bound := range h.bounds {
+		if other.bounds[index] != bound {
+			return ErrIncompatibleBuckets
+		}
+	}
+
+	for index, count := range other.counts {
+		h.counts[index] += count
+	}
+
+	h.n += other.n
+	h.sum += other.sum
+	h.min = math.Min(h.min, other.min)
+	h.max = math.Max(h.max, other.max)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (h *Histogram) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	fields := []any{
+		uint8(histogramVersion),
+		uint32(len(h.bounds)),
+		h.bounds,
+		h.counts,
+		h.n,
+		h.sum,
+		h.min,
+		h.max,
+	}
+	for _, field := range fields {
+		if err := binary.Write(&buf, binary.BigEndian, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *Histogram) UnmarshalBinary(data []byte) error {
+	reader := bytes.NewReader(data)
+
+	var version uint8
+	var numBounds uint32
+	for _, field := range []any{&version, &numBounds} {
+		if err := binary.Read(reader, binary.BigEndian, field); err != nil {
+			return ErrInvalidEncoding
+		}
+	}
+
+	if version != histogramVersion || int(numBounds)*16 > reader.Len() {
+		return ErrInvalidEncoding
+	}
+
+	decoded := Histogram{
+		bounds: make([]float64, numBounds),
+		counts: make([]uint64, numBounds+1),
+	}
+
+	fields := []any{decoded.bounds, decoded.counts, &decoded.n, &decoded.sum, &decoded.min, &decoded.max}
+	for _, field := range fields {
+		if err := binary.Read(reader, binary.BigEndian, field); err != nil {
+			return ErrInvalidEncoding
+		}
+	}
+
+	if reader.Len() != 0 || !sort.Float64sAreSorted(decoded.bounds) {
+		return ErrInvalidEncoding
+	}
+
+	var total uint64
+	for _, count := range decoded.counts {
+		total += count
+	}
+
+	if total != decoded.n {
+		return ErrInvalidEncoding
+	}
+
+	*h = decoded
+	return nil
+}
@@ -0,0 +1,120 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+func TestBuckets(t *testing.T) {
+	assert.Equal(t, []float64{1, 3, 5, 7}, LinearBuckets(1, 2, 4))
+	assert.Equal(t, []float64{1, 2, 4, 8}, ExponentialBuckets(1, 2, 4))
+}
+
+func TestHistogram_Observe(t *testing.T) {
+	histogram := NewHistogram(10, 0, 5, 5, math.NaN())
+	histogram.ObserveAll(iter.FromSlice([]float64{-1, 0, 1, 5, 6, 10, 11, math.NaN()}))
+
+	assert.Equal(t, []Bucket{
+		{Lower: math.Inf(-1), Upper: 0, Count: 2},
+		{Lower: 0, Upper: 5, Count: 2},
+		{Lower: 5, Upper: 10, Count: 2},
+		{Lower: 10, Upper: math.Inf(1), Count: 1},
+	}, iter.CollectSlice(histogram.Buckets()))
+	assert.Equal(t, uint64(7), histogram.Count())
+	assert.Equal(t, 32.0, histogram.Sum())
+}
+
+func TestHistogram_Quantile(t *testing.T) {
+	histogram := NewHistogram(LinearBuckets(10, 10, 9)...)
+	assert.Equal(t, oxide.None[float64](), histogram.Quantile(0.5))
+
+	histogram.ObserveAll(iter.FromSlice([]float64{5, 15, 25, 35}))
+	assert.Equal(t, oxide.Some(5.0), histogram.Quantile(0))
+	assert.Equal(t, oxide.Some(20.0), histogram.Quantile(0.5))
+	assert.Equal(t, oxide.Some(35.0), histogram.Quantile(1))
+	assert.Equal(t, oxide.None[float64](), histogram.Quantile(2))
+}
+
+func TestHistogram_ErrorBounds(t *testing.T) {
+	testIO := []struct {
+		name     string
+		bounds   []float64
+		data     []float64
+		maxError func(exact float64) float64
+	}{
+		{
+			name:   "should estimate within the width of linear buckets",
+			bounds: LinearBuckets(0, 0.05, 21),
+			data:   generate(6, 100000, (*rand.Rand).Float64),
+			maxError: func(float64) float64 {
+				return 0.05
+			},
+		},
+		{
+			name:   "should estimate within the growth factor of exponential buckets",
+			bounds: ExponentialBuckets(0.001, 1.1, 120),
+			data:   generate(7, 100000, (*rand.Rand).ExpFloat64),
+			maxError: func(exact float64) float64 {
+				return exact * 0.1
+			},
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			histogram := NewHistogram(test.bounds...)
+			histogram.ObserveAll(iter.FromSlice(test.data))
+
+			sorted := append([]float64(nil), test.data...)
+			sort.Float64s(sorted)
+
+			for q := 0.01; q < 1; q += 0.01 {
+				exact := sorted[int(q*float64(len(sorted)))]
+				estimate := histogram.Quantile(q).Value()
+
+				if math.Abs(estimate-exact) > test.maxError(exact) {
+					t.Errorf("quantile %v estimated as %v, expected %v", q, estimate, exact)
+				}
+			}
+		})
+	}
+}
+
+func TestHistogram_Merge(t *testing.T) {
+	left := NewHistogram(1, 2, 3)
+	left.ObserveAll(iter.FromSlice([]float64{0.5, 1.5, 2.5}))
+
+	right := NewHistogram(1, 2, 3)
+	right.ObserveAll(iter.FromSlice([]float64{2.5, 3.5}))
+
+	assert.Equal(t, nil, left.Merge(right))
+	assert.Equal(t, uint64(5), left.Count())
+	assert.Equal(t, []uint64{1, 1, 2, 1}, iter.CollectSlice(iter.Map(left.Buckets(), func(b Bucket) uint64 {
+		return b.Count
+	})))
+
+	assert.Equal(t, ErrIncompatibleBuckets, left.Merge(NewHistogram(1, 2)))
+	assert.Equal(t, ErrIncompatibleBuckets, left.Merge(NewHistogram(1, 2, 4)))
+	assert.Equal(t, uint64(5), left.Count())
+}
+
+func TestHistogram_Serialization(t *testing.T) {
+	histogram := NewHistogram(ExponentialBuckets(1, 2, 10)...)
+	histogram.ObserveAll(iter.FromSlice(generate(8, 1000, (*rand.Rand).ExpFloat64)))
+
+	data, err := histogram.MarshalBinary()
+	assert.Equal(t, nil, err)
+
+	decoded := &Histogram{}
+	assert.Equal(t, nil, decoded.UnmarshalBinary(data))
+	assert.Equal(t, histogram, decoded)
+
+	assert.Equal(t, ErrInvalidEncoding, decoded.UnmarshalBinary(data[:len(data)-1]))
+	assert.Equal(t, ErrInvalidEncoding, decoded.UnmarshalBinary(nil))
+}
@@ -0,0 +1,344 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// DefaultKLLK is the accuracy parameter used by NewKLL when a non-positive k
+// is provided.
+const DefaultKLLK = 200
+
+// minKLLK is the smallest accuracy parameter supported by KLL.
+const minKLLK = 8
+
+// kllVersion identifies the binary serialization format of a KLL sketch.
+const kllVersion = 1
+
+// ErrInvalidEncoding is returned when a sketch or histogram can not be decoded
+// from the provided binary data.
+var ErrInvalidEncoding = errors.New("stats: invalid encoding")
+
+// KLL is a streaming quantile sketch based on the algorithm described by
+// Karnin, Lang and Liberty in "Optimal Quantile Approximation in Streams".
+//
+// A KLL sketch summarizes an arbitrarily long stream of values using an amount
+// of memory which only grows logarithmically with the number of values
+// observed. Sketches built over separate shards of a stream can be combined
+// via Merge, and the result is as accurate as a sketch built over the entire
+// stream.
+//
+// The accuracy of a sketch is controlled by its parameter k. The estimated
+// normalized rank of any value is within NormalizedRankError(k) of its true
+// normalized rank with 99% confidence, which is roughly 1.33% for the default
+// k of 200. Doubling k approximately halves the error, at the cost of
+// approximately doubling the memory used by the sketch.
+//
+// The zero value is not ready for use; use NewKLL instead.
+type KLL struct {
+	k        int
+	n        uint64
+	min, max float64
+
+	// levels holds the retained values of the sketch, where each value at
+	// level h represents 2^h of the values which were observed.
+	levels [][]float64
+
+	// rng is the state of the xorshift generator which decides which half of
+	// a level is retained during each compaction. It is seeded with a fixed
+	// value so that sketches are reproducible.
+	rng uint64
+}
+
+// NewKLL returns a new, empty KLL sketch using the accuracy parameter k. If k
+// is less than or equal to zero, DefaultKLLK is used. Values of k smaller than
+// 8 are rounded up to 8.
+func NewKLL(k int) *KLL {
+	if k <= 0 {
+		k = DefaultKLLK
+	} else if k < minKLLK {
+		k = minKLLK
+	}
+
+	return &KLL{
+		k:      k,
+		min:    math.Inf(1),
+		max:    math.Inf(-1),
+		levels: [][]float64{make([]float64, 0, k)},
+		rng:    0x9e3779b97f4a7c15,
+	}
+}
+
+// NormalizedRankError returns the normalized rank error which a KLL sketch
+// with the accuracy parameter k stays within, with 99% confidence.
+func NormalizedRankError(k int) float64 {
+	return 2.296 / math.Pow(float64(k), 0.9723)
+}
+
+// Observe adds the provided value to the sketch. NaN values are ignored.
+func (s *KLL) Observe(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+
+	s.n++
+	s.min = math.Min(s.min, x)
+	s.max = math.Max(s.max, x)
+
+	s.levels[0] = append(s.levels[0], x)
+	s.compress()
+}
+
+// ObserveAll consumes the provided iterator, adding each of its values to the
+// sketch.
+func (s *KLL) ObserveAll(iterator iter.Interface[float64]) {
+	iter.ForEach(iterator, func(x *float64) {
+		s.Observe(*x)
+	})
+}
+
+// Count returns the number of values which have been observed by the sketch.
+func (s *KLL) Count() uint64 {
+	return s.n
+}
+
+// Quantile returns an estimate of the qth quantile of the observed values,
+// where q is in the range [0, 1]. The 0th and 1st quantiles are always exact.
+//
+// A None value is returned if no values have been observed, or if q is outside
+// the range [0, 1].
+func (s *KLL) Quantile(q float64) oxide.Option[float64] {
+	if s.n == 0 || q < 0 || q > 1 || math.IsNaN(q) {
+		return oxide.None[float64]()
+	}
+
+	if q == 0 {
+		return oxide.Some(s.min)
+	} else if q == 1 {
+		return oxide.Some(s.max)
+	}
+
+	items := s.weighted()
+	target := q * float64(s.n)
+
+	var cumulative uint64
+	for _, item := range items {
+		cumulative += item.weight
+		if float64(cumulative) >= target {
+			return oxide.Some(item.value)
+		}
+	}
+
+	return oxide.Some(s.max)
+}
+
+// Rank returns an estimate of the normalized rank of the provided value, which
+// is the fraction of observed values which are less than or equal to it.
+func (s *KLL) Rank(x float64) float64 {
+	if s.n == 0 {
+		return 0
+	}
+
+	var below uint64
+	for h, level := range s.levels {
+		for _, value := range level {
+			if value <= x {
+				below += 1 << h
+			}
+		}
+	}
+
+	return float64(below) / float64(s.n)
+}
+
+// Merge folds the values summarized by the provided sketch into this sketch.
+// The provided sketch is not modified.
+func (s *KLL) Merge(other *KLL) {
+	if other.n == 0 {
+		return
+	}
+
+	for h, level := range other.levels {
+		if h == len(s.levels) {
+			s.levels = append(s.levels, make([]float64, 0, len(level)))
+		}
+		s.levels[h] = append(s.levels[h], level...)
+	}
+
+	s.n += other.n
+	s.min = math.Min(s.min, other.min)
+	s.max = math.Max(s.max, other.max)
+	s.compress()
+}
+
+type weightedValue struct {
+	value  float64
+	weight uint64
+}
+
+// weighted returns all the retained values of the sketch alongside their
+// weights, sorted in ascending order of value.
+func (s *KLL) weighted() []weightedValue {
+	items := make([]weightedValue, 0, s.size())
+	for h, level := range s.levels {
+		for _, value := range level {
+			items = append(items, weightedValue{value: value, weight: 1 << h})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].value < items[j].value
+	})
+	return items
+}
+
+// size returns the total number of values which are retained by the sketch.
+func (s *KLL) size() int {
+	size := 0
+	for _, level := range s.levels {
+		size += len(level)
+	}
+
+	return size
+}
+
+// capacity returns the maximum number of values which may be retained at level
+// h of the sketch. Capacities decay geometrically from k at the top level.
+func (s *KLL) capacity(h int) int {
+	depth := len(s.levels) - 1 - h
+	return max(2, int(math.Ceil(float64(s.k)*math.Pow(2.0/3.0, float64(depth)))))
+}
+
+// compress compacts levels of the sketch until the number of retained values
+// is within the total capacity of the sketch.
+func (s *KLL) compress() {
+	for {
+		total := 0
+		for h := range s.levels {
+			total += s.capacity(h)
+		}
+
+		if s.size() <= total {
+			return
+		}
+
+		for h, level := range s.levels {
+			if len(level) >= s.capacity(h) {
+				s.compact(h)
+				break
+			}
+		}
+	}
+}
+
+// compact sorts the values at level h and promotes every other value to the
+// level above, which doubles the weight represented by the promoted values.
+func (s *KLL) compact(h int) {
+	if h+1 == len(s.levels) {
+		s.levels = append(s.levels, make([]float64, 0, s.k))
+	}
+
+	level := s.levels[h]
+	sort.Float64s(level)
+
+	// When there are an odd number of values, the least value is retained at
+	// the current level so that the total weight of the sketch is preserved.
+	retained := level[:len(level)%2]
+	pairs := level[len(level)%2:]
+
+	offset := int(s.random() & 1)
+	for index := offset; index < len(pairs); index += 2 {
+		s.levels[h+1] = append(s.levels[h+1], pairs[index])
+	}
+
+	s.levels[h] = append(level[:0], retained...)
+}
+
+// random advances the xorshift generator, returning its next value.
+func (s *KLL) random() uint64 {
+	s.rng ^= s.rng << 13
+	s.rng ^= s.rng >> 7
+	s.rng ^= s.rng << 17
+	return s.rng
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s *KLL) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := []any{
+		uint8(kllVersion),
+		uint32(s.k),
+		s.n,
+		s.min,
+		s.max,
+		s.rng,
+		uint32(len(s.levels)),
+	}
+	for _, field := range header {
+		if err := binary.Write(&buf, binary.BigEndian, field); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, level := range s.levels {
+		if err := binary.Write(&buf, binary.BigEndian, uint32(len(level))); err != nil {
+			return nil, err
+		}
+
+		if err := binary.Write(&buf, binary.BigEndian, level); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *KLL) UnmarshalBinary(data []byte) error {
+	reader := bytes.NewReader(data)
+
+	var version uint8
+	var k, numLevels uint32
+	decoded := KLL{}
+
+	header := []any{&version, &k, &decoded.n, &decoded.min, &decoded.max, &decoded.rng, &numLevels}
+	for _, field := range header {
+		if err := binary.Read(reader, binary.BigEndian, field); err != nil {
+			return ErrInvalidEncoding
+		}
+	}
+
+	if version != kllVersion || k < minKLLK || numLevels == 0 || int(numLevels) > reader.Len() {
+		return ErrInvalidEncoding
+	}
+	decoded.k = int(k)
+
+	var weight uint64
+	decoded.levels = make([][]float64, numLevels)
+	for h := range decoded.levels {
+		var size uint32
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil || int(size)*8 > reader.Len() {
+			return ErrInvalidEncoding
+		}
+
+		decoded.levels[h] = make([]float64, size)
+		if err := binary.Read(reader, binary.BigEndian, decoded.levels[h]); err != nil {
+			return ErrInvalidEncoding
+		}
+		weight += uint64(size) << h
+	}
+
+	if reader.Len() != 0 || weight != decoded.n {
+		return ErrInvalidEncoding
+	}
+
+	*s = decoded
+	return nil
+}
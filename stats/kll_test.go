@@ -0,0 +1,153 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// maxRankError returns the greatest difference between the requested quantile
+// and the true normalized rank of the value estimated for it by the sketch.
+func maxRankError(sketch *KLL, sorted []float64) float64 {
+	worst := 0.0
+	for q := 0.01; q < 1; q += 0.01 {
+		estimate := sketch.Quantile(q).Value()
+		rank := float64(sort.SearchFloat64s(sorted, estimate)) / float64(len(sorted))
+		worst = math.Max(worst, math.Abs(rank-q))
+	}
+
+	return worst
+}
+
+func generate(seed int64, n int, fn func(*rand.Rand) float64) []float64 {
+	random := rand.New(rand.NewSource(seed))
+	data := make([]float64, n)
+	for index := range data {
+		data[index] = fn(random)
+	}
+
+	return data
+}
+
+func TestKLL_ErrorBounds(t *testing.T) {
+	testIO := []struct {
+		name string
+		k    int
+		data []float64
+	}{
+		{
+			name: "should estimate uniform values",
+			k:    DefaultKLLK,
+			data: generate(1, 100000, (*rand.Rand).Float64),
+		},
+		{
+			name: "should estimate normal values",
+			k:    DefaultKLLK,
+			data: generate(2, 100000, (*rand.Rand).NormFloat64),
+		},
+		{
+			name: "should estimate exponential values with a small k",
+			k:    50,
+			data: generate(3, 100000, (*rand.Rand).ExpFloat64),
+		},
+		{
+			name: "should estimate sorted values",
+			k:    100,
+			data: iter.CollectSlice(iter.Map(iter.Range(0, 100000), func(i int) float64 {
+				return float64(i)
+			})),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			sketch := NewKLL(test.k)
+			sketch.ObserveAll(iter.FromSlice(test.data))
+
+			sorted := append([]float64(nil), test.data...)
+			sort.Float64s(sorted)
+
+			assert.Equal(t, uint64(len(test.data)), sketch.Count())
+			assert.Equal(t, true, maxRankError(sketch, sorted) <= NormalizedRankError(test.k))
+
+			// The sketch should retain far fewer values than it observed.
+			assert.Equal(t, true, sketch.size() < len(test.data)/50)
+
+			// The extreme quantiles are always exact.
+			assert.Equal(t, oxide.Some(sorted[0]), sketch.Quantile(0))
+			assert.Equal(t, oxide.Some(sorted[len(sorted)-1]), sketch.Quantile(1))
+		})
+	}
+}
+
+func TestKLL_Merge(t *testing.T) {
+	data := generate(4, 100000, (*rand.Rand).NormFloat64)
+
+	// Summarize the data across several shards, and then merge the shards.
+	merged := NewKLL(DefaultKLLK)
+	for shard := 0; shard < 10; shard++ {
+		sketch := NewKLL(DefaultKLLK)
+		sketch.ObserveAll(iter.FromSlice(data[shard*10000 : (shard+1)*10000]))
+		merged.Merge(sketch)
+	}
+
+	sorted := append([]float64(nil), data...)
+	sort.Float64s(sorted)
+
+	assert.Equal(t, uint64(len(data)), merged.Count())
+	assert.Equal(t, true, maxRankError(merged, sorted) <= NormalizedRankError(DefaultKLLK))
+	assert.Equal(t, oxide.Some(sorted[0]), merged.Quantile(0))
+	assert.Equal(t, oxide.Some(sorted[len(sorted)-1]), merged.Quantile(1))
+
+	// Merging an empty sketch should be a no-op.
+	merged.Merge(NewKLL(DefaultKLLK))
+	assert.Equal(t, uint64(len(data)), merged.Count())
+}
+
+func TestKLL_Empty(t *testing.T) {
+	sketch := NewKLL(0)
+	sketch.Observe(math.NaN())
+
+	assert.Equal(t, uint64(0), sketch.Count())
+	assert.Equal(t, oxide.None[float64](), sketch.Quantile(0.5))
+	assert.Equal(t, 0.0, sketch.Rank(1))
+}
+
+func TestKLL_Exact(t *testing.T) {
+	// Until the first compaction, every value is retained exactly.
+	sketch := NewKLL(DefaultKLLK)
+	sketch.ObserveAll(iter.FromSlice([]float64{5, 1, 4, 2, 3}))
+
+	assert.Equal(t, oxide.Some(1.0), sketch.Quantile(0.1))
+	assert.Equal(t, oxide.Some(3.0), sketch.Quantile(0.5))
+	assert.Equal(t, oxide.Some(5.0), sketch.Quantile(0.9))
+	assert.Equal(t, 0.6, sketch.Rank(3))
+	assert.Equal(t, oxide.None[float64](), sketch.Quantile(-0.1))
+	assert.Equal(t, oxide.None[float64](), sketch.Quantile(1.1))
+}
+
+func TestKLL_Serialization(t *testing.T) {
+	sketch := NewKLL(64)
+	sketch.ObserveAll(iter.FromSlice(generate(5, 10000, (*rand.Rand).Float64)))
+
+	data, err := sketch.MarshalBinary()
+	assert.Equal(t, nil, err)
+
+	decoded := &KLL{}
+	assert.Equal(t, nil, decoded.UnmarshalBinary(data))
+	assert.Equal(t, sketch, decoded)
+
+	// The decoded sketch should continue to behave identically.
+	sketch.Observe(0.5)
+	decoded.Observe(0.5)
+	assert.Equal(t, sketch.Quantile(0.5), decoded.Quantile(0.5))
+
+	assert.Equal(t, ErrInvalidEncoding, decoded.UnmarshalBinary(data[:len(data)-1]))
+	assert.Equal(t, ErrInvalidEncoding, decoded.UnmarshalBinary(nil))
+	assert.Equal(t, ErrInvalidEncoding, decoded.UnmarshalBinary(append(data, 0)))
+}
@@ -0,0 +1,266 @@
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/constraints"
+	"github.com/moogar0880/oxide/iter"
+)
+
+// ErrLengthMismatch is returned when two iterators whose values are paired up
+// yield a different number of values. It is the same error as
+// iter.ErrLengthMismatch.
+var ErrLengthMismatch = iter.ErrLengthMismatch
+
+// moments accumulates the count, mean and sum of squared differences from the
+// mean of a stream of values using Welford's online algorithm, which avoids
+// the catastrophic cancellation of the naive sum of squares approach.
+type moments struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+func (m *moments) push(x float64) {
+	m.n++
+	delta := x - m.mean
+	m.mean += delta / float64(m.n)
+	m.m2 += delta * (x - m.mean)
+}
+
+func collectMoments[T constraints.Number](iterator iter.Interface[T]) moments {
+	return iter.Fold(iterator, moments{}, func(accum moments, value *T) moments {
+		accum.push(float64(*value))
+		return accum
+	})
+}
+
+// Mean returns the arithmetic mean of all values yielded by the provided
+// iterator, or a None value if the iterator yields no values.
+func Mean[T constraints.Number](iterator iter.Interface[T]) oxide.Option[float64] {
+	m := collectMoments(iterator)
+	if m.n == 0 {
+		return oxide.None[float64]()
+	}
+
+	return oxide.Some(m.mean)
+}
+
+// Variance returns the population variance of all values yielded by the
+// provided iterator, or a None value if the iterator yields no values.
+//
+// The variance is computed in a single pass using Welford's online algorithm.
+func Variance[T constraints.Number](iterator iter.Interface[T]) oxide.Option[float64] {
+	m := collectMoments(iterator)
+	if m.n == 0 {
+		return oxide.None[float64]()
+	}
+
+	return oxide.Some(m.m2 / float64(m.n))
+}
+
+// SampleVariance returns the sample variance, using Bessel's correction, of
+// all values yielded by the provided iterator, or a None value if the iterator
+// yields fewer than two values.
+//
+// The variance is computed in a single pass using Welford's online algorithm.
+func SampleVariance[T constraints.Number](iterator iter.Interface[T]) oxide.Option[float64] {
+	m := collectMoments(iterator)
+	if m.n < 2 {
+		return oxide.None[float64]()
+	}
+
+	return oxide.Some(m.m2 / float64(m.n-1))
+}
+
+// StdDev returns the population standard deviation of all values yielded by
+// the provided iterator, or a None value if the iterator yields no values.
+func StdDev[T constraints.Number](iterator iter.Interface[T]) oxide.Option[float64] {
+	return sqrt(Variance(iterator))
+}
+
+// SampleStdDev returns the sample standard deviation of all values yielded by
+// the provided iterator, or a None value if the iterator yields fewer than two
+// values.
+func SampleStdDev[T constraints.Number](iterator iter.Interface[T]) oxide.Option[float64] {
+	return sqrt(SampleVariance(iterator))
+}
+
+func sqrt(variance oxide.Option[float64]) oxide.Option[float64] {
+	if variance.IsNone() {
+		return variance
+	}
+
+	return oxide.Some(math.Sqrt(variance.Value()))
+}
+
+// An Interpolation determines how a percentile is computed when it falls
+// between two values.
+type Interpolation int
+
+const (
+	// Linear interpolates linearly between the two surrounding values.
+	Linear Interpolation = iota
+
+	// Lower selects the lesser of the two surrounding values.
+	Lower
+
+	// Higher selects the greater of the two surrounding values.
+	Higher
+
+	// Nearest selects whichever of the two surrounding values is nearest,
+	// preferring the lesser value when the percentile falls exactly halfway.
+	Nearest
+
+	// Midpoint selects the mean of the two surrounding values.
+	Midpoint
+)
+
+// Median returns the median of all values yielded by the provided iterator, or
+// a None value if the iterator yields no values.
+//
+// When the iterator yields an even number of values, the mean of the two
+// middle values is returned.
+func Median[T constraints.Number](iterator iter.Interface[T]) oxide.Option[float64] {
+	return Percentile(iterator, 50, Linear)
+}
+
+// Percentile returns the pth percentile of all values yielded by the provided
+// iterator, where p is in the range [0, 100]. When the percentile falls
+// between two values, the provided Interpolation determines the result.
+//
+// A None value is returned if the iterator yields no values, or if p is
+// outside the range [0, 100]. Unlike the other statistics in this package,
+// Percentile needs to hold every value in memory in order to sort them.
+func Percentile[T constraints.Number](iterator iter.Interface[T], p float64, interpolation Interpolation) oxide.Option[float64] {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return oxide.None[float64]()
+	}
+
+	data := iter.CollectSlice(iter.Map(iterator, func(value T) float64 {
+		return float64(value)
+	}))
+	if len(data) == 0 {
+		return oxide.None[float64]()
+	}
+	sort.Float64s(data)
+
+	rank := p / 100 * float64(len(data)-1)
+	lower, upper := data[int(math.Floor(rank))], data[int(math.Ceil(rank))]
+	fraction := rank - math.Floor(rank)
+
+	switch interpolation {
+	case Lower:
+		return oxide.Some(lower)
+	case Higher:
+		return oxide.Some(upper)
+	case Nearest:
+		if fraction > 0.5 {
+			return oxide.Some(upper)
+		}
+
+		return oxide.Some(lower)
+	case Midpoint:
+		return oxide.Some((lower + upper) / 2)
+	default:
+		return oxide.Some(lower + (upper-lower)*fraction)
+	}
+}
+
+// Mode returns the most frequently yielded value from the provided iterator,
+// or a None value if the iterator yields no values.
+//
+// If several values are equally frequent, the value which was yielded first is
+// returned.
+func Mode[T constraints.Number](iterator iter.Interface[T]) oxide.Option[T] {
+	counts := make(map[T]int)
+	order := make([]T, 0)
+
+	for item, ok := iterator.Next(); ok; item, ok = iterator.Next() {
+		if _, seen := counts[item]; !seen {
+			order = append(order, item)
+		}
+		counts[item]++
+	}
+
+	mode, modeCount := oxide.None[T](), 0
+	for _, item := range order {
+		if count := counts[item]; count > modeCount {
+			mode, modeCount = oxide.Some(item), count
+		}
+	}
+
+	return mode
+}
+
+// comoments accumulates the moments of two streams of values alongside their
+// co-moment, which allows covariance and correlation to be computed in a
+// single pass.
+type comoments struct {
+	x, y moments
+	cxy  float64
+}
+
+func (c *comoments) push(x, y float64) {
+	dx := x - c.x.mean
+	c.x.push(x)
+	c.y.push(y)
+	c.cxy += dx * (y - c.y.mean)
+}
+
+// collectComoments consumes the provided iterators in lockstep, returning
+// ErrLengthMismatch if either iterator is exhausted before the other.
+func collectComoments[T constraints.Number](x, y iter.Interface[T]) (comoments, error) {
+	zipped := iter.ZipExact(x, y)
+	c := iter.Fold[[2]T](zipped, comoments{}, func(accum comoments, pair *[2]T) comoments {
+		accum.push(float64(pair[0]), float64(pair[1]))
+		return accum
+	})
+
+	return c, zipped.Err()
+}
+
+// Covariance consumes the provided iterators, returning the population
+// covariance of the pairs of values which they yield in lockstep, or a None
+// value if the iterators yield no values. ErrLengthMismatch is returned if the
+// iterators yield a different number of values.
+func Covariance[T constraints.Number](x, y iter.Interface[T]) (oxide.Option[float64], error) {
+	c, err := collectComoments(x, y)
+	if err != nil || c.x.n == 0 {
+		return oxide.None[float64](), err
+	}
+
+	return oxide.Some(c.cxy / float64(c.x.n)), nil
+}
+
+// SampleCovariance consumes the provided iterators, returning the sample
+// covariance of the pairs of values which they yield in lockstep, or a None
+// value if the iterators yield fewer than two values. ErrLengthMismatch is
+// returned if the iterators yield a different number of values.
+func SampleCovariance[T constraints.Number](x, y iter.Interface[T]) (oxide.Option[float64], error) {
+	c, err := collectComoments(x, y)
+	if err != nil || c.x.n < 2 {
+		return oxide.None[float64](), err
+	}
+
+	return oxide.Some(c.cxy / float64(c.x.n-1)), nil
+}
+
+// Correlation consumes the provided iterators, returning the Pearson
+// correlation coefficient of the pairs of values which they yield in lockstep.
+// ErrLengthMismatch is returned if the iterators yield a different number of
+// values.
+//
+// A None value is returned if the iterators yield no values, or if either
+// iterator's values have a variance of zero, in which case the correlation is
+// undefined.
+func Correlation[T constraints.Number](x, y iter.Interface[T]) (oxide.Option[float64], error) {
+	c, err := collectComoments(x, y)
+	if err != nil || c.x.n == 0 || c.x.m2 == 0 || c.y.m2 == 0 {
+		return oxide.None[float64](), err
+	}
+
+	return oxide.Some(c.cxy / math.Sqrt(c.x.m2*c.y.m2)), nil
+}
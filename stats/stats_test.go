@@ -0,0 +1,301 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moogar0880/oxide"
+	"github.com/moogar0880/oxide/assert"
+	"github.com/moogar0880/oxide/iter"
+)
+
+const epsilon = 1e-9
+
+// assertNear asserts that the actual Option matches the expected Option to
+// within a small tolerance.
+func assertNear(t *testing.T, expect, actual oxide.Option[float64]) {
+	t.Helper()
+
+	if expect.IsSome() != actual.IsSome() {
+		t.Errorf("actual value did not match expected\nexpected: %v\n actual=%v", expect, actual)
+		return
+	}
+
+	if math.Abs(expect.Value()-actual.Value()) > epsilon {
+		t.Errorf("actual value did not match expected\nexpected: %v\n actual=%v", expect.Value(), actual.Value())
+	}
+}
+
+func TestMoments(t *testing.T) {
+	testIO := []struct {
+		name                 string
+		data                 []float64
+		expectMean           oxide.Option[float64]
+		expectVariance       oxide.Option[float64]
+		expectSampleVariance oxide.Option[float64]
+	}{
+		{
+			name:                 "should handle no values",
+			data:                 []float64{},
+			expectMean:           oxide.None[float64](),
+			expectVariance:       oxide.None[float64](),
+			expectSampleVariance: oxide.None[float64](),
+		},
+		{
+			name:                 "should handle a single value",
+			data:                 []float64{4},
+			expectMean:           oxide.Some(4.0),
+			expectVariance:       oxide.Some(0.0),
+			expectSampleVariance: oxide.None[float64](),
+		},
+		{
+			name:                 "should handle many values",
+			data:                 []float64{2, 4, 4, 4, 5, 5, 7, 9},
+			expectMean:           oxide.Some(5.0),
+			expectVariance:       oxide.Some(4.0),
+			expectSampleVariance: oxide.Some(32.0 / 7.0),
+		},
+		{
+			name:                 "should handle values with a large offset",
+			data:                 []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16},
+			expectMean:           oxide.Some(1e9 + 10),
+			expectVariance:       oxide.Some(22.5),
+			expectSampleVariance: oxide.Some(30.0),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assertNear(t, test.expectMean, Mean(iter.FromSlice(test.data)))
+			assertNear(t, test.expectVariance, Variance(iter.FromSlice(test.data)))
+			assertNear(t, test.expectSampleVariance, SampleVariance(iter.FromSlice(test.data)))
+			assertNear(t, sqrt(test.expectVariance), StdDev(iter.FromSlice(test.data)))
+			assertNear(t, sqrt(test.expectSampleVariance), SampleStdDev(iter.FromSlice(test.data)))
+		})
+	}
+}
+
+func TestMean_Integers(t *testing.T) {
+	assertNear(t, oxide.Some(2.5), Mean(iter.FromSlice([]int{1, 2, 3, 4})))
+}
+
+func TestPercentile(t *testing.T) {
+	data := []int{15, 20, 35, 40, 50}
+
+	testIO := []struct {
+		name          string
+		data          []int
+		p             float64
+		interpolation Interpolation
+		expect        oxide.Option[float64]
+	}{
+		{
+			name:          "should handle no values",
+			data:          []int{},
+			p:             50,
+			interpolation: Linear,
+			expect:        oxide.None[float64](),
+		},
+		{
+			name:          "should reject a negative percentile",
+			data:          data,
+			p:             -1,
+			interpolation: Linear,
+			expect:        oxide.None[float64](),
+		},
+		{
+			name:          "should reject a percentile over 100",
+			data:          data,
+			p:             101,
+			interpolation: Linear,
+			expect:        oxide.None[float64](),
+		},
+		{
+			name:          "should find the 0th percentile",
+			data:          data,
+			p:             0,
+			interpolation: Linear,
+			expect:        oxide.Some(15.0),
+		},
+		{
+			name:          "should find the 100th percentile",
+			data:          data,
+			p:             100,
+			interpolation: Linear,
+			expect:        oxide.Some(50.0),
+		},
+		{
+			name:          "should interpolate linearly",
+			data:          data,
+			p:             40,
+			interpolation: Linear,
+			expect:        oxide.Some(29.0),
+		},
+		{
+			name:          "should interpolate lower",
+			data:          data,
+			p:             40,
+			interpolation: Lower,
+			expect:        oxide.Some(20.0),
+		},
+		{
+			name:          "should interpolate higher",
+			data:          data,
+			p:             40,
+			interpolation: Higher,
+			expect:        oxide.Some(35.0),
+		},
+		{
+			name:          "should interpolate nearest",
+			data:          data,
+			p:             40,
+			interpolation: Nearest,
+			expect:        oxide.Some(35.0),
+		},
+		{
+			name:          "should interpolate nearest at the halfway point",
+			data:          []int{1, 2},
+			p:             50,
+			interpolation: Nearest,
+			expect:        oxide.Some(1.0),
+		},
+		{
+			name:          "should interpolate midpoint",
+			data:          data,
+			p:             40,
+			interpolation: Midpoint,
+			expect:        oxide.Some(27.5),
+		},
+		{
+			name:          "should sort unsorted values",
+			data:          []int{50, 15, 40, 20, 35},
+			p:             40,
+			interpolation: Linear,
+			expect:        oxide.Some(29.0),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Percentile(iter.FromSlice(test.data), test.p, test.interpolation)
+			assertNear(t, test.expect, actual)
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	assertNear(t, oxide.None[float64](), Median(iter.FromSlice([]int{})))
+	assertNear(t, oxide.Some(3.0), Median(iter.FromSlice([]int{5, 1, 3})))
+	assertNear(t, oxide.Some(2.5), Median(iter.FromSlice([]int{4, 1, 3, 2})))
+}
+
+func TestMode(t *testing.T) {
+	testIO := []struct {
+		name   string
+		data   []int
+		expect oxide.Option[int]
+	}{
+		{
+			name:   "should handle no values",
+			data:   []int{},
+			expect: oxide.None[int](),
+		},
+		{
+			name:   "should find the most frequent value",
+			data:   []int{1, 2, 2, 3, 3, 3, 4},
+			expect: oxide.Some(3),
+		},
+		{
+			name:   "should break ties with the first value yielded",
+			data:   []int{4, 1, 1, 4, 2},
+			expect: oxide.Some(4),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Mode(iter.FromSlice(test.data)))
+		})
+	}
+}
+
+func TestCovariance(t *testing.T) {
+	testIO := []struct {
+		name                   string
+		x, y                   []float64
+		expectCovariance       oxide.Option[float64]
+		expectSampleCovariance oxide.Option[float64]
+		expectCorrelation      oxide.Option[float64]
+	}{
+		{
+			name:                   "should handle no values",
+			x:                      []float64{},
+			y:                      []float64{},
+			expectCovariance:       oxide.None[float64](),
+			expectSampleCovariance: oxide.None[float64](),
+			expectCorrelation:      oxide.None[float64](),
+		},
+		{
+			name:                   "should handle perfectly correlated values",
+			x:                      []float64{1, 2, 3, 4},
+			y:                      []float64{2, 4, 6, 8},
+			expectCovariance:       oxide.Some(2.5),
+			expectSampleCovariance: oxide.Some(10.0 / 3.0),
+			expectCorrelation:      oxide.Some(1.0),
+		},
+		{
+			name:                   "should handle perfectly anti-correlated values",
+			x:                      []float64{1, 2, 3, 4},
+			y:                      []float64{8, 6, 4, 2},
+			expectCovariance:       oxide.Some(-2.5),
+			expectSampleCovariance: oxide.Some(-10.0 / 3.0),
+			expectCorrelation:      oxide.Some(-1.0),
+		},
+		{
+			name:                   "should handle values with zero variance",
+			x:                      []float64{1, 2, 3},
+			y:                      []float64{5, 5, 5},
+			expectCovariance:       oxide.Some(0.0),
+			expectSampleCovariance: oxide.Some(0.0),
+			expectCorrelation:      oxide.None[float64](),
+		},
+		{
+			name:                   "should handle partially correlated values",
+			x:                      []float64{1, 2, 3, 4, 5},
+			y:                      []float64{2, 1, 4, 3, 5},
+			expectCovariance:       oxide.Some(1.6),
+			expectSampleCovariance: oxide.Some(2.0),
+			expectCorrelation:      oxide.Some(0.8),
+		},
+	}
+
+	for _, test := range testIO {
+		t.Run(test.name, func(t *testing.T) {
+			covariance, err := Covariance(iter.FromSlice(test.x), iter.FromSlice(test.y))
+			assert.Equal(t, nil, err)
+			assertNear(t, test.expectCovariance, covariance)
+
+			sampleCovariance, err := SampleCovariance(iter.FromSlice(test.x), iter.FromSlice(test.y))
+			assert.Equal(t, nil, err)
+			assertNear(t, test.expectSampleCovariance, sampleCovariance)
+
+			correlation, err := Correlation(iter.FromSlice(test.x), iter.FromSlice(test.y))
+			assert.Equal(t, nil, err)
+			assertNear(t, test.expectCorrelation, correlation)
+		})
+	}
+}
+
+func TestCovariance_LengthMismatch(t *testing.T) {
+	short, long := []float64{1, 2, 3}, []float64{1, 2, 3, 4}
+
+	covariance, err := Covariance(iter.FromSlice(short), iter.FromSlice(long))
+	assert.Equal(t, ErrLengthMismatch, err)
+	assert.Equal(t, oxide.None[float64](), covariance)
+
+	_, err = SampleCovariance(iter.FromSlice(long), iter.FromSlice(short))
+	assert.Equal(t, ErrLengthMismatch, err)
+
+	_, err = Correlation(iter.FromSlice(short), iter.FromSlice([]float64{}))
+	assert.Equal(t, ErrLengthMismatch, err)
+}